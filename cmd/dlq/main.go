@@ -0,0 +1,172 @@
+// Command dlq lists and replays records of the dead letter queues.
+//
+//	go run cmd/dlq/main.go list -topic image.liked [-since 2026-01-02T15:04:05Z] [-until ...] [-limit 100]
+//	go run cmd/dlq/main.go replay -topic image.liked -offsets 0:12,0:15
+//	go run cmd/dlq/main.go replay -topic image.liked -since 2026-01-02T15:04:05Z [-until ...]
+//
+// Records are printed as JSON lines on stdout, logs go to stderr.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/dlqusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg := config.NewConfig()
+
+	logkit.SetupLogger(cfg)
+	validatorkit.SetupValidator(cfg)
+
+	client := provider.NewKafkaClientProducer(cfg)
+	defer client.Close()
+
+	var dlqClient messaging.DLQClient
+	dlqClient = messaging.NewDLQClient(cfg, client)
+	dlqClient = messaging.NewDLQClientMwLogger(dlqClient)
+
+	var dlqUsecase dlqusecase.DLQUsecase
+	dlqUsecase = dlqusecase.NewDLQUsecase(cfg, dlqClient)
+	dlqUsecase = dlqusecase.NewDLQUsecaseMwLogger(dlqUsecase)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "list":
+		err = runList(ctx, dlqUsecase, os.Args[2:])
+	case "replay":
+		err = runReplay(ctx, dlqUsecase, os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		logkit.Logger.WithError(err).Error("dlq command failed")
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: dlq list|replay -topic <primary topic> [flags]")
+	os.Exit(2)
+}
+
+func runList(ctx context.Context, usecase dlqusecase.DLQUsecase, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	topicName := fs.String("topic", "", "primary topic whose DLQ is listed, e.g. image.liked")
+	since := fs.String("since", "", "only records produced at or after this RFC3339 time")
+	until := fs.String("until", "", "only records produced at or before this RFC3339 time")
+	limit := fs.Int("limit", 0, "maximum number of records, 0 means no limit")
+	_ = fs.Parse(args)
+
+	req := dto.ListDLQRequest{Topic: *topicName, Limit: *limit}
+	err := parseTimeRange(*since, *until, &req.Since, &req.Until)
+	if err != nil {
+		return err
+	}
+
+	records, err := usecase.List(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for _, record := range records {
+		err = encoder.Encode(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runReplay(ctx context.Context, usecase dlqusecase.DLQUsecase, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	topicName := fs.String("topic", "", "primary topic whose DLQ is replayed, e.g. image.liked")
+	offsets := fs.String("offsets", "", "comma separated partition:offset list of records to replay")
+	since := fs.String("since", "", "replay records produced at or after this RFC3339 time")
+	until := fs.String("until", "", "replay records produced at or before this RFC3339 time")
+	_ = fs.Parse(args)
+
+	req := dto.ReplayDLQRequest{Topic: *topicName}
+	err := parseTimeRange(*since, *until, &req.Since, &req.Until)
+	if err != nil {
+		return err
+	}
+	err = parseOffsets(*offsets, &req.Offsets)
+	if err != nil {
+		return err
+	}
+
+	res, err := usecase.Replay(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(res)
+}
+
+func parseTimeRange(since string, until string, sinceTime *time.Time, untilTime *time.Time) error {
+	var err error
+	if since != "" {
+		*sinceTime, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("invalid -since: %w", err)
+		}
+	}
+	if until != "" {
+		*untilTime, err = time.Parse(time.RFC3339, until)
+		if err != nil {
+			return fmt.Errorf("invalid -until: %w", err)
+		}
+	}
+	return nil
+}
+
+func parseOffsets(s string, offsets *dto.DLQOffsetList) error {
+	if s == "" {
+		return nil
+	}
+
+	for item := range strings.SplitSeq(s, ",") {
+		partition, offset, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return fmt.Errorf("invalid -offsets item %q, want partition:offset", item)
+		}
+
+		p, err := strconv.ParseInt(partition, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid partition in -offsets item %q: %w", item, err)
+		}
+		o, err := strconv.ParseInt(offset, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid offset in -offsets item %q: %w", item, err)
+		}
+
+		*offsets = append(*offsets, dto.DLQOffset{Partition: int32(p), Offset: o})
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Command imagesearchcleanup moves the image search documents indexed under a generated id,
+// before documents were indexed under the image id, to the image id. Run it once after
+// deploying the workers indexing under the image id, running it again finds nothing to move.
+//
+//	go run cmd/imagesearchcleanup/main.go
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/search"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func main() {
+	cfg := config.NewConfig()
+
+	logkit.SetupLogger(cfg)
+	validatorkit.SetupValidator(cfg)
+
+	elasticsearchClient := provider.NewElasticsearchClient(cfg)
+
+	var imageSearch search.ImageSearch
+	imageSearch = search.NewImageSearch(elasticsearchClient)
+	imageSearch = search.NewImageSearchMwLogger(imageSearch)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	deleted, err := imageSearch.DeleteLegacyImages(ctx)
+	if err != nil {
+		logkit.Logger.WithError(err).Error("image search cleanup failed")
+		os.Exit(1)
+	}
+	logkit.Logger.WithField("deleted", deleted).Info("legacy image search documents moved to the image id")
+}
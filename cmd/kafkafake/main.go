@@ -0,0 +1,44 @@
+// Command kafkafake runs an in-memory Kafka broker on the port of kafka.bootstrap.servers,
+// holding every topic of the topic manifest, so the services run locally without the
+// Kafka container. Records are lost when it stops.
+package main
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"github.com/twmb/franz-go/pkg/kfake"
+)
+
+func main() {
+	cfg := config.NewConfig()
+
+	logkit.SetupLogger(cfg)
+	validatorkit.SetupValidator(cfg)
+
+	broker := strings.Split(cfg.GetKafkaBootstrapServers(), ",")[0]
+	_, port, err := net.SplitHostPort(broker)
+	errkit.PanicIfErr(err)
+	portNumber, err := strconv.Atoi(port)
+	errkit.PanicIfErr(err)
+
+	cluster := provider.NewKafkaFakeCluster(cfg, kfake.Ports(portNumber))
+	defer cluster.Close()
+
+	logkit.Logger.WithField("address", cfg.GetKafkaBootstrapServers()).Info("in-memory Kafka broker started")
+
+	terminateSignals := make(chan os.Signal, 1)
+	signal.Notify(terminateSignals, syscall.SIGINT, syscall.SIGTERM)
+
+	s := <-terminateSignals
+	logkit.Logger.Info("Got one of stop signals, shutting down in-memory Kafka broker, SIGNAL NAME :", s)
+}
@@ -0,0 +1,72 @@
+// Command topic provisions the Kafka topics of topic.Manifest.
+//
+//	go run cmd/topic/main.go apply   # create missing topics, set retention
+//	go run cmd/topic/main.go apply -allow-partition-increase   # add missing partitions too
+//	go run cmd/topic/main.go check   # fail when a topic is missing
+//
+// Adding partitions moves keys to other partitions, so records of one key may be consumed
+// out of order until the old partitions are drained. apply refuses to unless asked.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg := config.NewConfig()
+
+	logkit.SetupLogger(cfg)
+	validatorkit.SetupValidator(cfg)
+
+	client := provider.NewKafkaClientProducer(cfg)
+	defer client.Close()
+
+	var topicAdmin messaging.TopicAdmin
+	topicAdmin = messaging.NewTopicAdmin(cfg, client)
+	topicAdmin = messaging.NewTopicAdminMwLogger(topicAdmin)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "apply":
+		err = runApply(ctx, topicAdmin, os.Args[2:])
+	case "check":
+		err = topicAdmin.Check(ctx)
+	default:
+		usage()
+	}
+	if err != nil {
+		logkit.Logger.WithError(err).Error("topic command failed")
+		os.Exit(1)
+	}
+}
+
+func runApply(ctx context.Context, topicAdmin messaging.TopicAdmin, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	allowPartitionIncrease := fs.Bool("allow-partition-increase", false, "add the missing partitions of existing topics")
+	_ = fs.Parse(args)
+
+	return topicAdmin.Apply(ctx, *allowPartitionIncrease)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: topic apply [-allow-partition-increase]|check")
+	os.Exit(2)
+}
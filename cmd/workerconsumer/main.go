@@ -10,6 +10,7 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dependency_injection"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging/route"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/otelkit"
@@ -27,6 +28,7 @@ func main() {
 	db := provider.NewDatabase(cfg)
 	s3Client := provider.NewAWSS3Client(cfg)
 	producer := provider.NewKafkaClientProducer(cfg)
+	checkTopics(cfg, producer)
 	redisClient := provider.NewRedisClient(cfg)
 	elasticsearchClient := provider.NewElasticsearchClient(cfg)
 
@@ -45,13 +47,30 @@ func main() {
 	runConsumers(cfg, producer, consumers)
 }
 
+// checkTopics fails fast when a topic the consumers read or route to is missing, since
+// topics are no longer created on first use.
+func checkTopics(cfg *config.Config, client *kgo.Client) {
+	var topicAdmin messaging.TopicAdmin
+	topicAdmin = messaging.NewTopicAdmin(cfg, client)
+	topicAdmin = messaging.NewTopicAdminMwLogger(topicAdmin)
+
+	err := topicAdmin.Check(context.Background())
+	if err != nil {
+		logkit.Logger.WithError(err).Panic("required Kafka topics are missing")
+	}
+}
+
 func runConsumers(cfg *config.Config, producer *kgo.Client, consumers *dependency_injection.Consumers) {
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
 
 	logkit.Logger.Info("starting worker service")
 
-	route.Setup(ctx, cfg, producer, consumers, wg)
+	err := route.Setup(ctx, cfg, producer, consumers, wg)
+	if err != nil {
+		cancel()
+		logkit.Logger.WithError(err).Panic("consumer registry is invalid")
+	}
 
 	terminateSignals := make(chan os.Signal, 1)
 	signal.Notify(terminateSignals, syscall.SIGINT, syscall.SIGTERM)
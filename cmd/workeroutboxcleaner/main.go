@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/outboxusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func main() {
+	cfg := config.NewConfig()
+
+	logkit.SetupLogger(cfg)
+	validatorkit.SetupValidator(cfg)
+
+	db := provider.NewDatabase(cfg)
+
+	var outboxRepository repository.OutboxRepository
+	outboxRepository = repository.NewOutboxRepository(cfg)
+	outboxRepository = repository.NewOutboxRepositoryMwLogger(outboxRepository)
+
+	var outboxUsecase outboxusecase.OutboxUsecase
+	outboxUsecase = outboxusecase.NewOutboxUsecase(cfg, db, outboxRepository)
+	outboxUsecase = outboxusecase.NewOutboxUsecaseMwLogger(outboxUsecase)
+
+	stopTraceProvider := telemetry.InitTraceProvider(cfg)
+	defer stopTraceProvider()
+
+	stopLogProvider := telemetry.InitLogProvider(cfg)
+	defer stopLogProvider()
+
+	runCleaner(cfg, outboxUsecase)
+}
+
+func runCleaner(cfg *config.Config, usecase outboxusecase.OutboxUsecase) {
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	logkit.Logger.Info("starting outbox cleanup worker")
+
+	retention := time.Duration(cfg.GetOutboxCleanupRetentionSeconds()) * time.Second
+	failedRetention := time.Duration(cfg.GetOutboxCleanupFailedRetentionSeconds()) * time.Second
+	interval := time.Duration(cfg.GetOutboxCleanupIntervalSeconds()) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ticker.C:
+				removed, err := usecase.CleanupProduced(ctx, retention)
+				if err != nil {
+					logkit.Logger.WithContext(ctx).WithError(err).Error("outbox cleanup failed")
+				} else if removed > 0 {
+					logkit.Logger.WithContext(ctx).WithField("removed", removed).Info("produced outbox records cleaned")
+				}
+
+				removed, err = usecase.CleanupFailed(ctx, failedRetention)
+				if err != nil {
+					logkit.Logger.WithContext(ctx).WithError(err).Error("failed outbox cleanup failed")
+				} else if removed > 0 {
+					logkit.Logger.WithContext(ctx).WithField("removed", removed).Info("failed outbox records cleaned")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	terminateSignals := make(chan os.Signal, 1)
+	signal.Notify(terminateSignals, syscall.SIGINT, syscall.SIGTERM)
+
+	s := <-terminateSignals
+	logkit.Logger.Info("Got one of stop signals, shutting down outbox cleaner, SIGNAL NAME :", s)
+
+	logkit.Logger.Info("canceling")
+	cancel()
+	logkit.Logger.Info("canceled")
+
+	logkit.Logger.Info("wait for all cleanup cycle to finish")
+	wg.Wait()
+	logkit.Logger.Info("done waiting")
+
+	logkit.Logger.Info("end process of outbox cleaner")
+}
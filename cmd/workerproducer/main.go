@@ -12,10 +12,12 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/otelkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"github.com/jackc/pgx/v5"
 )
 
 func main() {
@@ -27,6 +29,15 @@ func main() {
 	db := provider.NewDatabase(cfg)
 	producer := provider.NewKafkaClientProducer(cfg)
 
+	var topicAdmin messaging.TopicAdmin
+	topicAdmin = messaging.NewTopicAdmin(cfg, producer)
+	topicAdmin = messaging.NewTopicAdminMwLogger(topicAdmin)
+
+	err := topicAdmin.Check(context.Background())
+	if err != nil {
+		logkit.Logger.WithError(err).Panic("required Kafka topics are missing")
+	}
+
 	// setup outbox repository
 	var outboxRepository repository.OutboxRepository
 	outboxRepository = repository.NewOutboxRepository(cfg)
@@ -55,28 +66,20 @@ func runProducerLoop(cfg *config.Config, producer messaging.OutboxProducer) {
 	logkit.Logger.Info("starting outbox producer worker")
 
 	interval := time.Duration(cfg.GetOutboxPollIntervalSeconds()) * time.Second
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+
+	wake := make(chan struct{}, 1)
+	if cfg.GetOutboxListenEnabled() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			listenOutboxInserted(ctx, cfg, wake)
+		}()
+	}
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		for {
-			select {
-			case <-ticker.C:
-				err := producer.ProducePending(ctx)
-				if err != nil {
-					logkit.Logger.WithContext(ctx).WithError(err).Error("outbox produce failed")
-				}
-			case <-ctx.Done():
-				// Process remaining pending records one last time before exit
-				err := producer.ProducePending(context.Background())
-				if err != nil {
-					logkit.Logger.WithError(err).Error("outbox final produce failed")
-				}
-				return
-			}
-		}
+		produceOnTickOrWake(ctx, producer, interval, wake)
 	}()
 
 	terminateSignals := make(chan os.Signal, 1)
@@ -95,3 +98,92 @@ func runProducerLoop(cfg *config.Config, producer messaging.OutboxProducer) {
 
 	logkit.Logger.Info("end process of outbox producer")
 }
+
+// produceOnTickOrWake produces pending records every interval, and right away on wake. A wake
+// restarts the interval, the records it was for are produced already.
+func produceOnTickOrWake(ctx context.Context, producer messaging.OutboxProducer, interval time.Duration, wake <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := producer.ProducePending(ctx)
+			if err != nil {
+				logkit.Logger.WithContext(ctx).WithError(err).Error("outbox produce failed")
+			}
+		case <-wake:
+			err := producer.ProducePending(ctx)
+			if err != nil {
+				logkit.Logger.WithContext(ctx).WithError(err).Error("outbox produce failed")
+			}
+			ticker.Reset(interval)
+		case <-ctx.Done():
+			// Process remaining pending records one last time before exit
+			err := producer.ProducePending(context.Background())
+			if err != nil {
+				logkit.Logger.WithError(err).Error("outbox final produce failed")
+			}
+			return
+		}
+	}
+}
+
+// outboxInsertedChannel must match the channel notified by the outboxes insert trigger.
+const outboxInsertedChannel = "outbox_inserted"
+
+// listenOutboxInserted LISTENs for outbox inserts and signals wake on each notification.
+// When the connection drops it reconnects after a delay; the ticker in runProducerLoop keeps
+// polling meanwhile, so no record is left behind.
+func listenOutboxInserted(ctx context.Context, cfg *config.Config, wake chan<- struct{}) {
+	dsn := provider.NewDatabaseDSN(cfg)
+	reconnect := time.Duration(cfg.GetOutboxListenReconnectSeconds()) * time.Second
+
+	for ctx.Err() == nil {
+		err := waitOutboxInserted(ctx, dsn, wake)
+		if ctx.Err() != nil {
+			return
+		}
+		logkit.Logger.WithError(err).Warnf("outbox listener disconnected, falling back to polling, reconnecting in %s", reconnect)
+
+		select {
+		case <-time.After(reconnect):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func waitOutboxInserted(ctx context.Context, dsn string, wake chan<- struct{}) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return errkit.AddFuncName(err, "main.waitOutboxInserted")
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+outboxInsertedChannel)
+	if err != nil {
+		return errkit.AddFuncName(err, "main.waitOutboxInserted")
+	}
+
+	logkit.Logger.Info("outbox listener connected")
+
+	// Wake once right after (re)connecting to pick up anything inserted while disconnected.
+	signalWake(wake)
+
+	for {
+		_, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errkit.AddFuncName(err, "main.waitOutboxInserted")
+		}
+		signalWake(wake)
+	}
+}
+
+// signalWake never blocks; a pending wake already covers any newer inserts.
+func signalWake(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
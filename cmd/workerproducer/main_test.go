@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalWake_DoesNotBlockWhenWakePending(t *testing.T) {
+	wake := make(chan struct{}, 1)
+
+	// the second and third inserts are covered by the wake already pending
+	signalWake(wake)
+	signalWake(wake)
+	signalWake(wake)
+
+	require.Len(t, wake, 1)
+}
+
+func TestProduceOnTickOrWake_ProducesOnWake(t *testing.T) {
+	produced := make(chan struct{}, 10)
+	producer := &mock.OutboxProducerMock{
+		ProducePendingFunc: func(ctx context.Context) error {
+			produced <- struct{}{}
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wake := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// the ticker never fires during the test, only the wake triggers a produce
+		produceOnTickOrWake(ctx, producer, time.Hour, wake)
+	}()
+
+	signalWake(wake)
+
+	select {
+	case <-produced:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending records were not produced on wake")
+	}
+
+	cancel()
+	<-done
+
+	// the final produce on shutdown
+	require.Len(t, producer.ProducePendingCalls(), 2)
+}
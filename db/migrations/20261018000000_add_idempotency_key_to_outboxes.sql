@@ -0,0 +1,14 @@
+-- +migrate Up
+alter table outboxes
+    add column idempotency_key varchar(36) null;
+
+update outboxes
+set idempotency_key = gen_random_uuid()::text
+where idempotency_key is null;
+
+alter table outboxes
+    alter column idempotency_key set not null;
+
+-- +migrate Down
+alter table outboxes
+    drop column idempotency_key;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table outboxes
+    add column attempts        integer     not null default 0,
+    add column last_error      text        null,
+    add column next_attempt_at timestamptz not null default now();
+
+-- +migrate Down
+alter table outboxes
+    drop column next_attempt_at,
+    drop column last_error,
+    drop column attempts;
@@ -0,0 +1,7 @@
+-- +migrate Up
+create index idx_outboxes_pending
+on outboxes (created_at)
+where status = 'pending';
+
+-- +migrate Down
+drop index if exists idx_outboxes_pending;
@@ -0,0 +1,7 @@
+-- +migrate Up
+create index idx_outboxes_produced_updated_at
+on outboxes (updated_at)
+where status = 'produced';
+
+-- +migrate Down
+drop index if exists idx_outboxes_produced_updated_at;
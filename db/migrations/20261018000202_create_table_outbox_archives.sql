@@ -0,0 +1,23 @@
+-- +migrate Up
+create table outbox_archives
+(
+    id              bigint       primary key,
+    topic           varchar(255) not null,
+    key             varchar(255) null,
+    payload         bytea        not null,
+    trace_context   text         null,
+    idempotency_key varchar(36)  not null,
+    status          varchar(50)  not null,
+    attempts        integer      not null default 0,
+    last_error      text         null,
+    next_attempt_at timestamptz  not null,
+    created_at      timestamptz  not null,
+    updated_at      timestamptz  not null,
+    deleted_at      timestamptz  null,
+    archived_at     timestamptz  not null default now()
+);
+
+create index idx_outbox_archives_archived_at on outbox_archives (archived_at);
+
+-- +migrate Down
+drop table outbox_archives;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- +migrate StatementBegin
+create or replace function notify_outbox_inserted() returns trigger as $$
+begin
+    perform pg_notify('outbox_inserted', '');
+    return null;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger trg_outboxes_notify_inserted
+after insert on outboxes
+for each statement
+execute function notify_outbox_inserted();
+
+-- +migrate Down
+drop trigger if exists trg_outboxes_notify_inserted on outboxes;
+drop function if exists notify_outbox_inserted();
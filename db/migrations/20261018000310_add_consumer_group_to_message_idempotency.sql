@@ -0,0 +1,26 @@
+-- +migrate Up
+-- Keys are claimed per consumer group, every group consuming a topic processes each record
+-- once. Existing keys were claimed by whichever group came first, they are kept under an
+-- empty group and expire with the usual retention.
+alter table message_idempotency
+    add column consumer_group varchar(255) not null default '';
+
+alter table message_idempotency
+    drop constraint message_idempotency_pkey;
+
+alter table message_idempotency
+    add primary key (consumer_group, idempotency_key);
+
+-- +migrate Down
+delete
+from message_idempotency
+where ctid not in (select min(ctid) from message_idempotency group by idempotency_key);
+
+alter table message_idempotency
+    drop constraint message_idempotency_pkey;
+
+alter table message_idempotency
+    add primary key (idempotency_key);
+
+alter table message_idempotency
+    drop column consumer_group;
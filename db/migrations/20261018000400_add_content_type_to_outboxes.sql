@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table outboxes
+    add column content_type varchar(100) not null default '';
+
+alter table outbox_archives
+    add column content_type varchar(100) not null default '';
+
+-- +migrate Down
+alter table outbox_archives
+    drop column content_type;
+
+alter table outboxes
+    drop column content_type;
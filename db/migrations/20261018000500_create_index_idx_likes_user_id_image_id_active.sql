@@ -0,0 +1,19 @@
+-- +migrate Up
+-- keep the oldest like of every duplicate, so the index can be created
+update likes set deleted_at = now()
+where deleted_at is null
+and id not in (
+    select min(id) from likes where deleted_at is null group by user_id, image_id
+);
+create unique index idx_likes_user_id_image_id_active
+on likes (user_id, image_id)
+where (deleted_at is null);
+-- the duplicates were counted too, recount from the active likes
+update images set like_count = (
+    select count(*) from likes where likes.image_id = images.id and likes.deleted_at is null
+)
+where like_count <> (
+    select count(*) from likes where likes.image_id = images.id and likes.deleted_at is null
+);
+-- +migrate Down
+drop index idx_likes_user_id_image_id_active;
@@ -0,0 +1,28 @@
+-- +migrate Up
+-- keep the oldest follow of every duplicate, so the index can be created
+update follows set deleted_at = now()
+where deleted_at is null
+and id not in (
+    select min(id) from follows where deleted_at is null group by follower_id, following_id
+);
+create unique index idx_follows_follower_id_following_id_active
+on follows (follower_id, following_id)
+where (deleted_at is null);
+-- a user can not follow themselves, unfollow the ones who did before Follow refused it
+update follows set deleted_at = now()
+where deleted_at is null
+and follower_id = following_id;
+-- not valid, the self-follows unfollowed above are kept, the check holds for every new follow
+alter table follows add constraint
+chk_follows_follower_id_following_id check (follower_id <> following_id) not valid;
+-- the duplicates and self-follows were counted too, recount from the active follows
+update user_stats set
+    follower_count = (
+        select count(*) from follows where follows.following_id = user_stats.user_id and follows.deleted_at is null
+    ),
+    following_count = (
+        select count(*) from follows where follows.follower_id = user_stats.user_id and follows.deleted_at is null
+    );
+-- +migrate Down
+alter table follows drop constraint chk_follows_follower_id_following_id;
+drop index idx_follows_follower_id_following_id_active;
@@ -15,6 +15,7 @@ func NewConfig() *Config {
 
 	v.SetConfigName("config")
 	v.SetConfigType("json")
+	v.AddConfigPath("./../../../../")
 	v.AddConfigPath("./../../../")
 	v.AddConfigPath("./../../")
 	v.AddConfigPath("./../")
@@ -114,8 +115,55 @@ func (c *Config) GetKafkaBootstrapServers() string {
 	return c.GetString(KafkaBootstrapServers)
 }
 
+func (c *Config) SetKafkaBootstrapServers(value string) {
+	c.Set(KafkaBootstrapServers, value)
+}
+
+// GetKafkaAutoOffsetReset returns where a consumer group without a committed offset starts
+// reading, earliest or latest.
 func (c *Config) GetKafkaAutoOffsetReset() string {
-	return c.GetString(KafkaAutoOffsetReset)
+	v := c.GetString(KafkaAutoOffsetReset)
+	if v != "" {
+		return v
+	}
+	return "earliest"
+}
+
+// GetKafkaSASLMechanism returns PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, empty disables SASL.
+func (c *Config) GetKafkaSASLMechanism() string {
+	return c.GetString(KafkaSASLMechanism)
+}
+
+func (c *Config) GetKafkaSASLUsername() string {
+	return c.GetString(KafkaSASLUsername)
+}
+
+func (c *Config) GetKafkaSASLPassword() string {
+	return c.GetString(KafkaSASLPassword)
+}
+
+func (c *Config) GetKafkaTLSEnabled() bool {
+	return c.GetBool(KafkaTLSEnabled)
+}
+
+// GetKafkaTLSCAFile returns the PEM file of the CA that signed the brokers' certificates,
+// empty uses the system pool.
+func (c *Config) GetKafkaTLSCAFile() string {
+	return c.GetString(KafkaTLSCAFile)
+}
+
+// GetKafkaTLSCertFile returns the PEM file of the client certificate for mutual TLS, used
+// together with GetKafkaTLSKeyFile.
+func (c *Config) GetKafkaTLSCertFile() string {
+	return c.GetString(KafkaTLSCertFile)
+}
+
+func (c *Config) GetKafkaTLSKeyFile() string {
+	return c.GetString(KafkaTLSKeyFile)
+}
+
+func (c *Config) GetKafkaTLSInsecureSkipVerify() bool {
+	return c.GetBool(KafkaTLSInsecureSkipVerify)
 }
 
 func (c *Config) GetKafkaConsumerMaxRetries() int {
@@ -126,10 +174,151 @@ func (c *Config) GetKafkaConsumerMaxRetries() int {
 	return 3
 }
 
+// GetKafkaConsumerRetryDelaysSeconds returns the delay of every retry tier, tier 1 first.
+func (c *Config) GetKafkaConsumerRetryDelaysSeconds() []int {
+	v := c.GetIntSlice(KafkaConsumerRetryDelaysSeconds)
+	if len(v) > 0 {
+		return v
+	}
+	return []int{10, 60, 600}
+}
+
+// GetKafkaConsumerHandlerTimeoutSeconds returns how long a consumer handler may run on one
+// message (or one batch) before its context is cancelled.
+func (c *Config) GetKafkaConsumerHandlerTimeoutSeconds() int {
+	v := c.GetInt(KafkaConsumerHandlerTimeoutSeconds)
+	if v > 0 {
+		return v
+	}
+	return 30
+}
+
+// GetKafkaConsumerHandlerGraceSeconds returns how long a consumer handler is waited for once
+// its context is cancelled, before it is abandoned and its records go to the DLQ.
+func (c *Config) GetKafkaConsumerHandlerGraceSeconds() int {
+	v := c.GetInt(KafkaConsumerHandlerGraceSeconds)
+	if v > 0 {
+		return v
+	}
+	return 5
+}
+
+// GetKafkaConsumerRouteBackoffSeconds returns how long a consumer waits before routing a
+// failed record to its retry or DLQ topic again, doubled on every attempt after the first.
+func (c *Config) GetKafkaConsumerRouteBackoffSeconds() int {
+	v := c.GetInt(KafkaConsumerRouteBackoffSeconds)
+	if v > 0 {
+		return v
+	}
+	return 1
+}
+
+// GetKafkaConsumerRouteMaxBackoffSeconds returns the most a consumer waits between two
+// attempts to route a failed record.
+func (c *Config) GetKafkaConsumerRouteMaxBackoffSeconds() int {
+	v := c.GetInt(KafkaConsumerRouteMaxBackoffSeconds)
+	if v > 0 {
+		return v
+	}
+	return 60
+}
+
+// GetKafkaConsumerMaxPollRecords returns how many records a single consumer polls at once,
+// spread over the partitions it is assigned.
+func (c *Config) GetKafkaConsumerMaxPollRecords() int {
+	v := c.GetInt(KafkaConsumerMaxPollRecords)
+	if v > 0 {
+		return v
+	}
+	return 500
+}
+
+// GetKafkaConsumerConcurrency returns how many handlers a worker runs at the same time,
+// across all its consumers and partitions.
+func (c *Config) GetKafkaConsumerConcurrency() int {
+	v := c.GetInt(KafkaConsumerConcurrency)
+	if v > 0 {
+		return v
+	}
+	return 64
+}
+
+// GetKafkaConsumerFetchMaxWaitMilliseconds returns how long a broker may hold a fetch
+// while waiting for the fetch min bytes.
+func (c *Config) GetKafkaConsumerFetchMaxWaitMilliseconds() int {
+	v := c.GetInt(KafkaConsumerFetchMaxWaitMilliseconds)
+	if v > 0 {
+		return v
+	}
+	return 5000
+}
+
+// GetKafkaConsumerFetchMinBytes returns the bytes a broker waits for before answering a
+// fetch of a single consumer.
+func (c *Config) GetKafkaConsumerFetchMinBytes() int {
+	v := c.GetInt(KafkaConsumerFetchMinBytes)
+	if v > 0 {
+		return v
+	}
+	return 1
+}
+
+// GetKafkaConsumerBatchFetchMinBytes returns the bytes a broker waits for before answering a
+// fetch of a batch consumer, so batches fill up instead of trickling in.
+func (c *Config) GetKafkaConsumerBatchFetchMinBytes() int {
+	v := c.GetInt(KafkaConsumerBatchFetchMinBytes)
+	if v > 0 {
+		return v
+	}
+	return 1024 * 1024
+}
+
+// GetKafkaConsumerFetchMaxBytes returns the most bytes a fetch returns, 0 keeps the client
+// default.
+func (c *Config) GetKafkaConsumerFetchMaxBytes() int {
+	return c.GetInt(KafkaConsumerFetchMaxBytes)
+}
+
 func (c *Config) GetKafkaProducerEnabled() bool {
 	return c.GetBool(KafkaProducerEnabled)
 }
 
+// GetKafkaProducerProtobufTopics returns the primary topics whose events are produced as
+// Protobuf, every other topic is produced as JSON.
+func (c *Config) GetKafkaProducerProtobufTopics() []string {
+	return c.GetStringSlice(KafkaProducerProtobufTopics)
+}
+
+// GetKafkaProducerLingerMilliseconds returns how long the producer waits for a batch to
+// fill before sending it, 0 keeps the client default.
+func (c *Config) GetKafkaProducerLingerMilliseconds() int {
+	return c.GetInt(KafkaProducerLingerMilliseconds)
+}
+
+// GetKafkaProducerBatchMaxBytes returns the largest batch the producer sends to a
+// partition, 0 keeps the client default.
+func (c *Config) GetKafkaProducerBatchMaxBytes() int {
+	return c.GetInt(KafkaProducerBatchMaxBytes)
+}
+
+func (c *Config) GetKafkaProducerRequestRetries() int {
+	v := c.GetInt(KafkaProducerRequestRetries)
+	if v > 0 {
+		return v
+	}
+	return 20
+}
+
+// GetKafkaTopicReplicationFactor returns the replication factor topics are created with,
+// see topic.Manifest.
+func (c *Config) GetKafkaTopicReplicationFactor() int16 {
+	v := c.GetInt(KafkaTopicReplicationFactor)
+	if v > 0 {
+		return int16(v)
+	}
+	return 1
+}
+
 func (c *Config) GetOutboxPollIntervalSeconds() int {
 	return c.GetInt(OutboxPollIntervalSeconds)
 }
@@ -138,6 +327,80 @@ func (c *Config) GetOutboxBatchSize() int {
 	return c.GetInt(OutboxBatchSize)
 }
 
+func (c *Config) GetOutboxMaxAttempts() int {
+	v := c.GetInt(OutboxMaxAttempts)
+	if v > 0 {
+		return v
+	}
+	return 10
+}
+
+func (c *Config) GetOutboxBackoffSeconds() int {
+	v := c.GetInt(OutboxBackoffSeconds)
+	if v > 0 {
+		return v
+	}
+	return 5
+}
+
+func (c *Config) GetOutboxMaxBackoffSeconds() int {
+	v := c.GetInt(OutboxMaxBackoffSeconds)
+	if v > 0 {
+		return v
+	}
+	return 3600
+}
+
+func (c *Config) GetOutboxListenEnabled() bool {
+	return c.GetBool(OutboxListenEnabled)
+}
+
+func (c *Config) GetOutboxListenReconnectSeconds() int {
+	v := c.GetInt(OutboxListenReconnectSeconds)
+	if v > 0 {
+		return v
+	}
+	return 5
+}
+
+func (c *Config) GetOutboxCleanupIntervalSeconds() int {
+	v := c.GetInt(OutboxCleanupIntervalSeconds)
+	if v > 0 {
+		return v
+	}
+	return 3600
+}
+
+func (c *Config) GetOutboxCleanupRetentionSeconds() int {
+	v := c.GetInt(OutboxCleanupRetentionSeconds)
+	if v > 0 {
+		return v
+	}
+	return 7 * 24 * 3600
+}
+
+// GetOutboxCleanupFailedRetentionSeconds is how long failed outbox rows are kept, longer
+// than produced ones so they can be looked into.
+func (c *Config) GetOutboxCleanupFailedRetentionSeconds() int {
+	v := c.GetInt(OutboxCleanupFailedRetentionSeconds)
+	if v > 0 {
+		return v
+	}
+	return 30 * 24 * 3600
+}
+
+func (c *Config) GetOutboxCleanupBatchSize() int {
+	v := c.GetInt(OutboxCleanupBatchSize)
+	if v > 0 {
+		return v
+	}
+	return 1000
+}
+
+func (c *Config) GetOutboxCleanupArchive() bool {
+	return c.GetBool(OutboxCleanupArchive)
+}
+
 func (c *Config) GetIdempotencyCleanupIntervalSeconds() int {
 	v := c.GetInt(IdempotencyCleanupIntervalSeconds)
 	if v > 0 {
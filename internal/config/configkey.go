@@ -22,13 +22,49 @@ const (
 
 	ElasticsearchAddress = "elasticsearch.address"
 
-	KafkaBootstrapServers   = "kafka.bootstrap.servers"
-	KafkaAutoOffsetReset    = "kafka.auto.offset.reset"
-	KafkaConsumerMaxRetries = "kafka.consumer.max_retries"
-	KafkaProducerEnabled    = "kafka.producer.enabled"
+	KafkaBootstrapServers                 = "kafka.bootstrap.servers"
+	KafkaAutoOffsetReset                  = "kafka.auto.offset.reset"
+	KafkaSASLMechanism                    = "kafka.sasl.mechanism"
+	KafkaSASLUsername                     = "kafka.sasl.username"
+	KafkaSASLPassword                     = "kafka.sasl.password"
+	KafkaTLSEnabled                       = "kafka.tls.enabled"
+	KafkaTLSCAFile                        = "kafka.tls.ca_file"
+	KafkaTLSCertFile                      = "kafka.tls.cert_file"
+	KafkaTLSKeyFile                       = "kafka.tls.key_file"
+	KafkaTLSInsecureSkipVerify            = "kafka.tls.insecure_skip_verify"
+	KafkaConsumerMaxRetries               = "kafka.consumer.max_retries"
+	KafkaConsumerRetryDelaysSeconds       = "kafka.consumer.retry_delays_seconds"
+	KafkaConsumerHandlerTimeoutSeconds    = "kafka.consumer.handler_timeout_seconds"
+	KafkaConsumerHandlerGraceSeconds      = "kafka.consumer.handler_grace_seconds"
+	KafkaConsumerRouteBackoffSeconds      = "kafka.consumer.route_backoff_seconds"
+	KafkaConsumerRouteMaxBackoffSeconds   = "kafka.consumer.route_max_backoff_seconds"
+	KafkaConsumerMaxPollRecords           = "kafka.consumer.max_poll_records"
+	KafkaConsumerConcurrency              = "kafka.consumer.concurrency"
+	KafkaConsumerFetchMaxWaitMilliseconds = "kafka.consumer.fetch_max_wait_ms"
+	KafkaConsumerFetchMinBytes            = "kafka.consumer.fetch_min_bytes"
+	KafkaConsumerFetchMaxBytes            = "kafka.consumer.fetch_max_bytes"
+	KafkaConsumerBatchFetchMinBytes       = "kafka.consumer.batch_fetch_min_bytes"
+	KafkaProducerEnabled                  = "kafka.producer.enabled"
+	KafkaProducerProtobufTopics           = "kafka.producer.protobuf_topics"
+	KafkaProducerLingerMilliseconds       = "kafka.producer.linger_ms"
+	KafkaProducerBatchMaxBytes            = "kafka.producer.batch_max_bytes"
+	KafkaProducerRequestRetries           = "kafka.producer.request_retries"
+	KafkaTopicReplicationFactor           = "kafka.topic.replication_factor"
 
 	OutboxPollIntervalSeconds = "outbox.poll_interval_seconds"
 	OutboxBatchSize           = "outbox.batch_size"
+	OutboxMaxAttempts         = "outbox.max_attempts"
+	OutboxBackoffSeconds      = "outbox.backoff_seconds"
+	OutboxMaxBackoffSeconds   = "outbox.max_backoff_seconds"
+
+	OutboxListenEnabled          = "outbox.listen.enabled"
+	OutboxListenReconnectSeconds = "outbox.listen.reconnect_seconds"
+
+	OutboxCleanupIntervalSeconds        = "outbox.cleanup.interval_seconds"
+	OutboxCleanupRetentionSeconds       = "outbox.cleanup.retention_seconds"
+	OutboxCleanupFailedRetentionSeconds = "outbox.cleanup.failed_retention_seconds"
+	OutboxCleanupBatchSize              = "outbox.cleanup.batch_size"
+	OutboxCleanupArchive                = "outbox.cleanup.archive"
 
 	LogLevel = "log.level"
 
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func KGoRecordToDtoDLQRecord(record *kgo.Record, dlqRecord *dto.DLQRecord) {
+	dlqRecord.Topic = record.Topic
+	dlqRecord.Partition = record.Partition
+	dlqRecord.Offset = record.Offset
+	dlqRecord.Timestamp = record.Timestamp
+	dlqRecord.Key = string(record.Key)
+	dlqRecord.Value = string(record.Value)
+
+	for _, h := range record.Headers {
+		dlqRecord.Headers = append(dlqRecord.Headers, dto.DLQHeader{Key: h.Key, Value: string(h.Value)})
+
+		switch h.Key {
+		case header.RetryCount:
+			n, err := strconv.Atoi(string(h.Value))
+			if err == nil {
+				dlqRecord.RetryCount = n
+			}
+		case header.ErrorMessage:
+			dlqRecord.ErrorMessage = string(h.Value)
+		case header.ErrorClass:
+			dlqRecord.ErrorClass = string(h.Value)
+		case header.ErrorConsumerGroup:
+			dlqRecord.ConsumerGroup = string(h.Value)
+		case header.OriginalTopic:
+			dlqRecord.OriginalTopic = string(h.Value)
+		case header.OriginalPartition:
+			dlqRecord.OriginalPartition = string(h.Value)
+		case header.OriginalOffset:
+			dlqRecord.OriginalOffset = string(h.Value)
+		case header.FirstFailureAt:
+			dlqRecord.FirstFailureAt = string(h.Value)
+		}
+	}
+}
+
+// DtoDLQRecordToKGoRecordReplay builds the record that replays dlqRecord to primaryTopic.
+// Retry and failure headers (header.Failure) are dropped, every other header such as
+// x-idempotency-key is kept.
+func DtoDLQRecordToKGoRecordReplay(dlqRecord dto.DLQRecord, primaryTopic string, record *kgo.Record) {
+	record.Topic = primaryTopic
+	if dlqRecord.Key != "" {
+		record.Key = []byte(dlqRecord.Key)
+	}
+	record.Value = []byte(dlqRecord.Value)
+
+	for _, h := range dlqRecord.Headers {
+		if slices.Contains(header.Failure, h.Key) {
+			continue
+		}
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: h.Key, Value: []byte(h.Value)})
+	}
+}
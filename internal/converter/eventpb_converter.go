@@ -0,0 +1,232 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema/eventpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func timeToTimestamppb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestamppbToTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func deletedAtToTimestamppb(deletedAt gorm.DeletedAt) *timestamppb.Timestamp {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return timestamppb.New(deletedAt.Time)
+}
+
+func timestamppbToDeletedAt(ts *timestamppb.Timestamp) gorm.DeletedAt {
+	if ts == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: ts.AsTime(), Valid: true}
+}
+
+func DtoImageUploadedEventToEventpbImageUploaded(event dto.ImageUploadedEvent, message *eventpb.ImageUploaded) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.Caption = event.Caption
+	message.Url = event.URL
+	message.LikeCount = int64(event.LikeCount)
+	message.CommentCount = int64(event.CommentCount)
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageUploadedToDtoImageUploadedEvent(message *eventpb.ImageUploaded, event *dto.ImageUploadedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.Caption = message.GetCaption()
+	event.URL = message.GetUrl()
+	event.LikeCount = int(message.GetLikeCount())
+	event.CommentCount = int(message.GetCommentCount())
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageUpdatedEventToEventpbImageUpdated(event dto.ImageUpdatedEvent, message *eventpb.ImageUpdated) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.Caption = event.Caption
+	message.Url = event.URL
+	message.LikeCount = int64(event.LikeCount)
+	message.CommentCount = int64(event.CommentCount)
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageUpdatedToDtoImageUpdatedEvent(message *eventpb.ImageUpdated, event *dto.ImageUpdatedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.Caption = message.GetCaption()
+	event.URL = message.GetUrl()
+	event.LikeCount = int(message.GetLikeCount())
+	event.CommentCount = int(message.GetCommentCount())
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageDeletedEventToEventpbImageDeleted(event dto.ImageDeletedEvent, message *eventpb.ImageDeleted) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.Caption = event.Caption
+	message.Url = event.URL
+	message.LikeCount = int64(event.LikeCount)
+	message.CommentCount = int64(event.CommentCount)
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageDeletedToDtoImageDeletedEvent(message *eventpb.ImageDeleted, event *dto.ImageDeletedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.Caption = message.GetCaption()
+	event.URL = message.GetUrl()
+	event.LikeCount = int(message.GetLikeCount())
+	event.CommentCount = int(message.GetCommentCount())
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageLikedEventToEventpbImageLiked(event dto.ImageLikedEvent, message *eventpb.ImageLiked) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.ImageId = event.ImageID
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageLikedToDtoImageLikedEvent(message *eventpb.ImageLiked, event *dto.ImageLikedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.ImageID = message.GetImageId()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageUnlikedEventToEventpbImageUnliked(event dto.ImageUnlikedEvent, message *eventpb.ImageUnliked) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.ImageId = event.ImageID
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageUnlikedToDtoImageUnlikedEvent(message *eventpb.ImageUnliked, event *dto.ImageUnlikedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.ImageID = message.GetImageId()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageCommentedEventToEventpbImageCommented(event dto.ImageCommentedEvent, message *eventpb.ImageCommented) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.ImageId = event.ImageID
+	message.Comment = event.Comment
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageCommentedToDtoImageCommentedEvent(message *eventpb.ImageCommented, event *dto.ImageCommentedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.ImageID = message.GetImageId()
+	event.Comment = message.GetComment()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoImageCommentDeletedEventToEventpbImageCommentDeleted(event dto.ImageCommentDeletedEvent, message *eventpb.ImageCommentDeleted) {
+	message.Id = event.ID
+	message.UserId = event.UserID
+	message.ImageId = event.ImageID
+	message.Comment = event.Comment
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbImageCommentDeletedToDtoImageCommentDeletedEvent(message *eventpb.ImageCommentDeleted, event *dto.ImageCommentDeletedEvent) {
+	event.ID = message.GetId()
+	event.UserID = message.GetUserId()
+	event.ImageID = message.GetImageId()
+	event.Comment = message.GetComment()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoUserFollowedEventToEventpbUserFollowed(event dto.UserFollowedEvent, message *eventpb.UserFollowed) {
+	message.Id = event.ID
+	message.FollowerId = event.FollowerID
+	message.FollowingId = event.FollowingID
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbUserFollowedToDtoUserFollowedEvent(message *eventpb.UserFollowed, event *dto.UserFollowedEvent) {
+	event.ID = message.GetId()
+	event.FollowerID = message.GetFollowerId()
+	event.FollowingID = message.GetFollowingId()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoUserUnfollowedEventToEventpbUserUnfollowed(event dto.UserUnfollowedEvent, message *eventpb.UserUnfollowed) {
+	message.Id = event.ID
+	message.FollowerId = event.FollowerID
+	message.FollowingId = event.FollowingID
+	message.CreatedAt = timeToTimestamppb(event.CreatedAt)
+	message.UpdatedAt = timeToTimestamppb(event.UpdatedAt)
+	message.DeletedAt = deletedAtToTimestamppb(event.DeletedAt)
+}
+
+func EventpbUserUnfollowedToDtoUserUnfollowedEvent(message *eventpb.UserUnfollowed, event *dto.UserUnfollowedEvent) {
+	event.ID = message.GetId()
+	event.FollowerID = message.GetFollowerId()
+	event.FollowingID = message.GetFollowingId()
+	event.CreatedAt = timestamppbToTime(message.GetCreatedAt())
+	event.UpdatedAt = timestamppbToTime(message.GetUpdatedAt())
+	event.DeletedAt = timestamppbToDeletedAt(message.GetDeletedAt())
+}
+
+func DtoNotifEventToEventpbNotif(event dto.NotifEvent, message *eventpb.Notif) {
+	message.UserId = event.UserID
+	message.Message = event.Message
+}
+
+func EventpbNotifToDtoNotifEvent(message *eventpb.Notif, event *dto.NotifEvent) {
+	event.UserID = message.GetUserId()
+	event.Message = message.GetMessage()
+}
@@ -2,7 +2,6 @@ package converter
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,7 +11,6 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
-	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func DtoUploadImageRequestToDtoS3UploadImageRequest(ctx context.Context, req dto.UploadImageRequest, s3UploadImgReq *dto.S3UploadImageRequest) error {
@@ -56,6 +54,39 @@ func EntityLikeToDtoImageLikedEvent(like entity.Like, event *dto.ImageLikedEvent
 	event.DeletedAt = like.DeletedAt
 }
 
+func EntityImageToDtoImageUpdatedEvent(image entity.Image, event *dto.ImageUpdatedEvent) {
+	event.ID = image.ID
+	event.UserID = image.UserID
+	event.Caption = image.Caption
+	event.URL = image.URL
+	event.LikeCount = image.LikeCount
+	event.CommentCount = image.CommentCount
+	event.CreatedAt = image.CreatedAt
+	event.UpdatedAt = image.UpdatedAt
+	event.DeletedAt = image.DeletedAt
+}
+
+func EntityImageToDtoImageDeletedEvent(image entity.Image, event *dto.ImageDeletedEvent) {
+	event.ID = image.ID
+	event.UserID = image.UserID
+	event.Caption = image.Caption
+	event.URL = image.URL
+	event.LikeCount = image.LikeCount
+	event.CommentCount = image.CommentCount
+	event.CreatedAt = image.CreatedAt
+	event.UpdatedAt = image.UpdatedAt
+	event.DeletedAt = image.DeletedAt
+}
+
+func EntityLikeToDtoImageUnlikedEvent(like entity.Like, event *dto.ImageUnlikedEvent) {
+	event.ID = like.ID
+	event.UserID = like.UserID
+	event.ImageID = like.ImageID
+	event.CreatedAt = like.CreatedAt
+	event.UpdatedAt = like.UpdatedAt
+	event.DeletedAt = like.DeletedAt
+}
+
 func DtoCommentImageRequestToEntityComment(ctx context.Context, req dto.CommentImageRequest, comment *entity.Comment) {
 	userAuth := ctxuserauth.Get(ctx)
 	comment.UserID = userAuth.ID
@@ -73,13 +104,23 @@ func EntityCommentToDtoImageCommentedEvent(comment entity.Comment, event *dto.Im
 	event.DeletedAt = comment.DeletedAt
 }
 
+func EntityCommentToDtoImageCommentDeletedEvent(comment entity.Comment, event *dto.ImageCommentDeletedEvent) {
+	event.ID = comment.ID
+	event.UserID = comment.UserID
+	event.ImageID = comment.ImageID
+	event.Comment = comment.Comment
+	event.CreatedAt = comment.CreatedAt
+	event.UpdatedAt = comment.UpdatedAt
+	event.DeletedAt = comment.DeletedAt
+}
+
 func EntityImageToDtoImageResponse(image entity.Image, res *dto.ImageResponse) {
 	res.ID = image.ID
 	res.UserID = image.UserID
 	res.Caption = image.Caption
 	res.URL = image.URL
-	res.LikeCount = image.LikeCount
-	res.CommentCount = image.CommentCount
+	res.LikeCount = max(image.LikeCount, 0)
+	res.CommentCount = max(image.CommentCount, 0)
 	res.CreatedAt = image.CreatedAt
 	res.UpdatedAt = image.UpdatedAt
 	res.DeletedAt = image.DeletedAt
@@ -137,6 +178,27 @@ func DtoImageUploadedEventToDtoSyncImageToElasticsearchRequest(event dto.ImageUp
 	req.DeletedAt = event.DeletedAt
 }
 
+func DtoImageUpdatedEventToDtoSyncImageToElasticsearchRequest(event dto.ImageUpdatedEvent, req *dto.SyncImageToElasticsearchRequest) {
+	req.ID = event.ID
+	req.UserID = event.UserID
+	req.Caption = event.Caption
+	req.URL = event.URL
+	req.LikeCount = event.LikeCount
+	req.CommentCount = event.CommentCount
+	req.CreatedAt = event.CreatedAt
+	req.UpdatedAt = event.UpdatedAt
+	req.DeletedAt = event.DeletedAt
+}
+
+func DtoImageDeletedEventToDtoDeleteImageObjectRequest(event dto.ImageDeletedEvent, req *dto.DeleteImageObjectRequest) {
+	req.URL = event.URL
+}
+
+func DtoImageDeletedEventToDtoRemoveImageFromElasticsearchRequest(event dto.ImageDeletedEvent, req *dto.RemoveImageFromElasticsearchRequest) {
+	req.ID = event.ID
+	req.DeletedAt = event.DeletedAt.Time
+}
+
 func DtoSyncImageToElasticsearchRequestToDtoImageDocument(req dto.SyncImageToElasticsearchRequest, imageDocument *dto.ImageDocument) {
 	imageDocument.ID = req.ID
 	imageDocument.UserID = req.UserID
@@ -154,19 +216,28 @@ func DtoImageCommentedEventToDtoNotifyUserImageCommentedRequest(event dto.ImageC
 	req.CommenterUserID = event.UserID
 }
 
-func KGoRecordListToDtoBatchUpdateImageCommentCountRequest(ctx context.Context, records []*kgo.Record, req *dto.BatchUpdateImageCommentCountRequest) {
+func DtoImageCommentedEventToDtoImageIncreaseCommentCount(event dto.ImageCommentedEvent, object *dto.ImageIncreaseCommentCount) {
+	object.ImageID = event.ImageID
+	object.Count = 1
+}
+
+func DtoImageCommentDeletedEventToDtoImageIncreaseCommentCount(event dto.ImageCommentDeletedEvent, object *dto.ImageIncreaseCommentCount) {
+	object.ImageID = event.ImageID
+	object.Count = -1
+}
+
+// DtoImageIncreaseCommentCountListToDtoBatchUpdateImageCommentCountRequest nets the changes
+// per image, leaving out the images whose added and deleted comments cancel each other out.
+func DtoImageIncreaseCommentCountListToDtoBatchUpdateImageCommentCountRequest(list dto.ImageIncreaseCommentCountList, req *dto.BatchUpdateImageCommentCountRequest) {
 	mapCounter := make(map[int64]int)
-	for _, record := range records {
-		event := dto.ImageCommentedEvent{}
-		err := json.Unmarshal(record.Value, &event)
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).Warn("Failed to unmarshal image commented event")
-			continue
-		}
-		mapCounter[event.ImageID]++
+	for _, v := range list {
+		mapCounter[v.ImageID] += v.Count
 	}
 
 	for imageID, count := range mapCounter {
+		if count == 0 {
+			continue
+		}
 		object := dto.ImageIncreaseCommentCount{
 			ImageID: imageID,
 			Count:   count,
@@ -180,19 +251,28 @@ func DtoImageLikedEventToDtoNotifyUserImageLikedRequest(event dto.ImageLikedEven
 	req.LikerUserID = event.UserID
 }
 
-func KGoRecordListToDtoBatchUpdateImageLikeCountRequest(ctx context.Context, records []*kgo.Record, req *dto.BatchUpdateImageLikeCountRequest) {
+func DtoImageLikedEventToDtoImageIncreaseLikeCount(event dto.ImageLikedEvent, object *dto.ImageIncreaseLikeCount) {
+	object.ImageID = event.ImageID
+	object.Count = 1
+}
+
+func DtoImageUnlikedEventToDtoImageIncreaseLikeCount(event dto.ImageUnlikedEvent, object *dto.ImageIncreaseLikeCount) {
+	object.ImageID = event.ImageID
+	object.Count = -1
+}
+
+// DtoImageIncreaseLikeCountListToDtoBatchUpdateImageLikeCountRequest nets the changes per
+// image, leaving out the images whose likes and unlikes cancel each other out.
+func DtoImageIncreaseLikeCountListToDtoBatchUpdateImageLikeCountRequest(list dto.ImageIncreaseLikeCountList, req *dto.BatchUpdateImageLikeCountRequest) {
 	mapCounter := make(map[int64]int)
-	for _, record := range records {
-		event := dto.ImageLikedEvent{}
-		err := json.Unmarshal(record.Value, &event)
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).Warn("Failed to unmarshal image liked event")
-			continue
-		}
-		mapCounter[event.ImageID]++
+	for _, v := range list {
+		mapCounter[v.ImageID] += v.Count
 	}
 
 	for imageID, count := range mapCounter {
+		if count == 0 {
+			continue
+		}
 		object := dto.ImageIncreaseLikeCount{
 			ImageID: imageID,
 			Count:   count,
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+)
+
+func EntityOutboxToEntityOutboxArchive(outbox entity.Outbox, archive *entity.OutboxArchive) {
+	archive.ID = outbox.ID
+	archive.Topic = outbox.Topic
+	archive.Key = outbox.Key
+	archive.Payload = outbox.Payload
+	archive.ContentType = outbox.ContentType
+	archive.TraceContext = outbox.TraceContext
+	archive.IdempotencyKey = outbox.IdempotencyKey
+	archive.Status = outbox.Status
+	archive.Attempts = outbox.Attempts
+	archive.LastError = outbox.LastError
+	archive.NextAttemptAt = outbox.NextAttemptAt
+	archive.CreatedAt = outbox.CreatedAt
+	archive.UpdatedAt = outbox.UpdatedAt
+	archive.DeletedAt = outbox.DeletedAt
+}
+
+func EntityOutboxListToEntityOutboxArchiveList(outboxes entity.OutboxList, archives *entity.OutboxArchiveList) {
+	for _, outbox := range outboxes {
+		archive := entity.OutboxArchive{}
+		EntityOutboxToEntityOutboxArchive(outbox, &archive)
+		*archives = append(*archives, archive)
+	}
+}
@@ -2,13 +2,10 @@ package converter
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
-	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func DtoRegisterUserRequestToEntityUser(req dto.RegisterUserRequest, user *entity.User, password string) {
@@ -67,26 +64,47 @@ func EntityFollowToDtoUserFollowedEvent(follow entity.Follow, event *dto.UserFol
 	event.DeletedAt = follow.DeletedAt
 }
 
+func EntityFollowToDtoUserUnfollowedEvent(follow entity.Follow, event *dto.UserUnfollowedEvent) {
+	event.ID = follow.ID
+	event.FollowerID = follow.FollowerID
+	event.FollowingID = follow.FollowingID
+	event.CreatedAt = follow.CreatedAt
+	event.UpdatedAt = follow.UpdatedAt
+	event.DeletedAt = follow.DeletedAt
+}
+
 func DtoUserFollowedEventToDtoNotifyUserBeingFollowedRequest(event dto.UserFollowedEvent, req *dto.NotifyUserBeingFollowedRequest) {
 	req.FollowerID = event.FollowerID
 	req.FollowingID = event.FollowingID
 }
 
-func KGoRecordListToDtoBatchUpdateUserFollowStatsRequest(ctx context.Context, records []*kgo.Record, req *dto.BatchUpdateUserFollowStatsRequest) {
+func DtoUserFollowedEventToDtoUserFollowChange(event dto.UserFollowedEvent, change *dto.UserFollowChange) {
+	change.FollowerID = event.FollowerID
+	change.FollowingID = event.FollowingID
+	change.Count = 1
+}
+
+func DtoUserUnfollowedEventToDtoUserFollowChange(event dto.UserUnfollowedEvent, change *dto.UserFollowChange) {
+	change.FollowerID = event.FollowerID
+	change.FollowingID = event.FollowingID
+	change.Count = -1
+}
+
+// DtoUserFollowChangeListToDtoBatchUpdateUserFollowStatsRequest nets the changes per user,
+// leaving out the users whose follows and unfollows cancel each other out.
+func DtoUserFollowChangeListToDtoBatchUpdateUserFollowStatsRequest(changes dto.UserFollowChangeList, req *dto.BatchUpdateUserFollowStatsRequest) {
 	userFollowerCounts := make(map[int64]int)
 	userFollowingCounts := make(map[int64]int)
 
-	for _, record := range records {
-		event := dto.UserFollowedEvent{}
-		err := json.Unmarshal(record.Value, &event)
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).Warn("Failed to unmarshal user followed event")
-			continue
-		}
-		userFollowerCounts[event.FollowingID]++
-		userFollowingCounts[event.FollowerID]++
+	for _, change := range changes {
+		userFollowerCounts[change.FollowingID] += change.Count
+		userFollowingCounts[change.FollowerID] += change.Count
 	}
 
+	userFollowCountsToDtoBatchUpdateUserFollowStatsRequest(userFollowerCounts, userFollowingCounts, req)
+}
+
+func userFollowCountsToDtoBatchUpdateUserFollowStatsRequest(userFollowerCounts map[int64]int, userFollowingCounts map[int64]int, req *dto.BatchUpdateUserFollowStatsRequest) {
 	allUserIDs := make(map[int64]struct{})
 	for id := range userFollowerCounts {
 		allUserIDs[id] = struct{}{}
@@ -101,6 +119,9 @@ func KGoRecordListToDtoBatchUpdateUserFollowStatsRequest(ctx context.Context, re
 			FollowerCount:  userFollowerCounts[id],
 			FollowingCount: userFollowingCounts[id],
 		}
+		if !object.HasFollowerCount() && !object.HasFollowingCount() {
+			continue
+		}
 		req.UserIncreaseFollowerFollowingCountList = append(req.UserIncreaseFollowerFollowingCountList, object)
 	}
 }
@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+type DLQRecord struct {
+	Topic             string        `json:"topic"`
+	Partition         int32         `json:"partition"`
+	Offset            int64         `json:"offset"`
+	Timestamp         time.Time     `json:"timestamp"`
+	Key               string        `json:"key"`
+	Value             string        `json:"value"`
+	RetryCount        int           `json:"retry_count"`
+	ErrorMessage      string        `json:"error_message"`
+	ErrorClass        string        `json:"error_class"`
+	ConsumerGroup     string        `json:"consumer_group"`
+	OriginalTopic     string        `json:"original_topic"`
+	OriginalPartition string        `json:"original_partition"`
+	OriginalOffset    string        `json:"original_offset"`
+	FirstFailureAt    string        `json:"first_failure_at"`
+	Headers           DLQHeaderList `json:"headers"`
+}
+
+type DLQRecordList []DLQRecord
+
+type DLQHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type DLQHeaderList []DLQHeader
+
+type DLQOffset struct {
+	Partition int32 `json:"partition"`
+	Offset    int64 `json:"offset"`
+}
+
+type DLQOffsetList []DLQOffset
+
+type ListDLQRequest struct {
+	Topic string `validate:"required"`
+	Since time.Time
+	Until time.Time
+	Limit int `validate:"gte=0"`
+}
+
+type ReplayDLQRequest struct {
+	Topic   string `validate:"required"`
+	Offsets DLQOffsetList
+	Since   time.Time
+	Until   time.Time
+}
+
+type ReplayDLQResponse struct {
+	Replayed int `json:"replayed"`
+}
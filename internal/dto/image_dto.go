@@ -28,11 +28,35 @@ type LikeImageRequest struct {
 	ImageID int64 `json:"image_id" validate:"required"`
 }
 
+type UnlikeImageRequest struct {
+	ImageID int64 `validate:"required"`
+}
+
 type CommentImageRequest struct {
 	ImageID int64  `json:"image_id" validate:"required"`
 	Comment string `json:"comment"  validate:"required"`
 }
 
+type UpdateCommentRequest struct {
+	ImageID   int64  `json:"-"       validate:"required"`
+	CommentID int64  `json:"-"       validate:"required"`
+	Comment   string `json:"comment" validate:"required"`
+}
+
+type DeleteCommentRequest struct {
+	ImageID   int64 `validate:"required"`
+	CommentID int64 `validate:"required"`
+}
+
+type UpdateImageRequest struct {
+	ID      int64  `json:"-"       validate:"required"`
+	Caption string `json:"caption"`
+}
+
+type DeleteImageRequest struct {
+	ID int64 `validate:"required"`
+}
+
 type GetImageRequest struct {
 	ID int64 `validate:"required"`
 }
@@ -85,6 +109,15 @@ type SyncImageToElasticsearchRequest struct {
 	DeletedAt    gorm.DeletedAt
 }
 
+type DeleteImageObjectRequest struct {
+	URL string `validate:"required"`
+}
+
+type RemoveImageFromElasticsearchRequest struct {
+	ID        int64     `validate:"required"`
+	DeletedAt time.Time `validate:"required"`
+}
+
 type NotifyUserImageCommentedRequest struct {
 	ImageID         int64
 	CommenterUserID int64
@@ -94,6 +127,8 @@ type BatchUpdateImageCommentCountRequest struct {
 	ImageIncreaseCommentCountList ImageIncreaseCommentCountList
 }
 
+// ImageIncreaseCommentCount is the net change of the comment count of one image, negative
+// when more comments were deleted than added.
 type ImageIncreaseCommentCount struct {
 	ImageID int64
 	Count   int
@@ -110,6 +145,8 @@ type BatchUpdateImageLikeCountRequest struct {
 	ImageIncreaseLikeCountList ImageIncreaseLikeCountList
 }
 
+// ImageIncreaseLikeCount is a change of the like count of one image, negative when more
+// likes were removed than added.
 type ImageIncreaseLikeCount struct {
 	ImageID int64
 	Count   int
@@ -129,6 +166,30 @@ type ImageUploadedEvent struct {
 	DeletedAt    gorm.DeletedAt `json:"deleted_at"`
 }
 
+type ImageUpdatedEvent struct {
+	ID           int64          `json:"id"`
+	UserID       int64          `json:"user_id"`
+	Caption      string         `json:"caption"`
+	URL          string         `json:"url"`
+	LikeCount    int            `json:"like_count"`
+	CommentCount int            `json:"comment_count"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at"`
+}
+
+type ImageDeletedEvent struct {
+	ID           int64          `json:"id"`
+	UserID       int64          `json:"user_id"`
+	Caption      string         `json:"caption"`
+	URL          string         `json:"url"`
+	LikeCount    int            `json:"like_count"`
+	CommentCount int            `json:"comment_count"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at"`
+}
+
 type ImageDocument struct {
 	ID           int64          `json:"id"`
 	UserID       int64          `json:"user_id"`
@@ -150,6 +211,19 @@ type ImageLikedEvent struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
 }
 
+type ImageLikedEventList []ImageLikedEvent
+
+type ImageUnlikedEvent struct {
+	ID        int64          `json:"id"`
+	UserID    int64          `json:"user_id"`
+	ImageID   int64          `json:"image_id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+type ImageUnlikedEventList []ImageUnlikedEvent
+
 type ImageCommentedEvent struct {
 	ID        int64          `json:"id"`
 	UserID    int64          `json:"user_id"`
@@ -159,3 +233,17 @@ type ImageCommentedEvent struct {
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
 }
+
+type ImageCommentedEventList []ImageCommentedEvent
+
+type ImageCommentDeletedEvent struct {
+	ID        int64          `json:"id"`
+	UserID    int64          `json:"user_id"`
+	ImageID   int64          `json:"image_id"`
+	Comment   string         `json:"comment"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+type ImageCommentDeletedEventList []ImageCommentDeletedEvent
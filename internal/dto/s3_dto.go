@@ -20,6 +20,10 @@ type S3DeleteObjectResponse struct {
 	Deleted bool
 }
 
+type S3DeleteImageRequest struct {
+	URL string
+}
+
 type S3UploadImageRequest struct {
 	Key  string
 	Body io.Reader
@@ -54,7 +54,11 @@ type GetUserRequest struct {
 }
 
 type FollowUserRequest struct {
-	FollowingID int64 `json:"following_id"`
+	FollowingID int64 `json:"following_id" validate:"required"`
+}
+
+type UnfollowUserRequest struct {
+	FollowingID int64 `json:"following_id" validate:"required"`
 }
 
 type NotifyUserBeingFollowedRequest struct {
@@ -66,6 +70,8 @@ type BatchUpdateUserFollowStatsRequest struct {
 	UserIncreaseFollowerFollowingCountList UserIncreaseFollowerFollowingCountList
 }
 
+// UserIncreaseFollowerFollowingCount is the net change of the stats of one user, a count is
+// negative when more follows were removed than added.
 type UserIncreaseFollowerFollowingCount struct {
 	UserID         int64
 	FollowerCount  int
@@ -73,11 +79,11 @@ type UserIncreaseFollowerFollowingCount struct {
 }
 
 func (u UserIncreaseFollowerFollowingCount) HasFollowerCount() bool {
-	return u.FollowerCount > 0
+	return u.FollowerCount != 0
 }
 
 func (u UserIncreaseFollowerFollowingCount) HasFollowingCount() bool {
-	return u.FollowingCount > 0
+	return u.FollowingCount != 0
 }
 
 func (u UserIncreaseFollowerFollowingCount) HasFollowerCountAndFollowingCount() bool {
@@ -86,6 +92,16 @@ func (u UserIncreaseFollowerFollowingCount) HasFollowerCountAndFollowingCount()
 
 type UserIncreaseFollowerFollowingCountList []UserIncreaseFollowerFollowingCount
 
+// UserFollowChange is a follow, Count 1, or an unfollow, Count -1, of FollowingID by
+// FollowerID.
+type UserFollowChange struct {
+	FollowerID  int64
+	FollowingID int64
+	Count       int
+}
+
+type UserFollowChangeList []UserFollowChange
+
 type UserFollowedEvent struct {
 	ID          int64          `json:"id"`
 	FollowerID  int64          `json:"follower_id"`
@@ -95,6 +111,19 @@ type UserFollowedEvent struct {
 	DeletedAt   gorm.DeletedAt `json:"deleted_at"`
 }
 
+type UserFollowedEventList []UserFollowedEvent
+
 func (u *UserFollowedEvent) GetID() string {
 	return strconv.FormatInt(u.ID, 10)
 }
+
+type UserUnfollowedEvent struct {
+	ID          int64          `json:"id"`
+	FollowerID  int64          `json:"follower_id"`
+	FollowingID int64          `json:"following_id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at"`
+}
+
+type UserUnfollowedEventList []UserUnfollowedEvent
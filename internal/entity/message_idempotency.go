@@ -7,6 +7,7 @@ import (
 )
 
 type MessageIdempotency struct {
+	ConsumerGroup  string    `gorm:"column:consumer_group;primaryKey"`
 	IdempotencyKey string    `gorm:"column:idempotency_key;primaryKey"`
 	Topic          string    `gorm:"column:topic"`
 	Partition      int32     `gorm:"column:partition"`
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/table"
+	"gorm.io/gorm"
+)
+
+type OutboxArchive struct {
+	ID             int64          `gorm:"column:id;primaryKey"`
+	Topic          string         `gorm:"column:topic"`
+	Key            string         `gorm:"column:key"`
+	Payload        []byte         `gorm:"column:payload"`
+	ContentType    string         `gorm:"column:content_type"`
+	TraceContext   string         `gorm:"column:trace_context"`
+	IdempotencyKey string         `gorm:"column:idempotency_key"`
+	Status         string         `gorm:"column:status"`
+	Attempts       int            `gorm:"column:attempts"`
+	LastError      string         `gorm:"column:last_error"`
+	NextAttemptAt  time.Time      `gorm:"column:next_attempt_at"`
+	CreatedAt      time.Time      `gorm:"column:created_at"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at"`
+	ArchivedAt     time.Time      `gorm:"column:archived_at;autoCreateTime"`
+}
+
+func (o *OutboxArchive) TableName() string {
+	return table.OutboxArchive
+}
+
+type OutboxArchiveList []OutboxArchive
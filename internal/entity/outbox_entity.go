@@ -8,15 +8,20 @@ import (
 )
 
 type Outbox struct {
-	ID           int64          `gorm:"column:id;primaryKey"`
-	Topic        string         `gorm:"column:topic"`
-	Key          string         `gorm:"column:key"`
-	Payload      []byte         `gorm:"column:payload"`
-	TraceContext string         `gorm:"column:trace_context"`
-	Status       string         `gorm:"column:status"`
-	CreatedAt    time.Time      `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt    time.Time      `gorm:"column:updated_at;autoUpdateTime"`
-	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at"`
+	ID             int64          `gorm:"column:id;primaryKey"`
+	Topic          string         `gorm:"column:topic"`
+	Key            string         `gorm:"column:key"`
+	Payload        []byte         `gorm:"column:payload"`
+	ContentType    string         `gorm:"column:content_type"`
+	TraceContext   string         `gorm:"column:trace_context"`
+	IdempotencyKey string         `gorm:"column:idempotency_key"`
+	Status         string         `gorm:"column:status"`
+	Attempts       int            `gorm:"column:attempts"`
+	LastError      string         `gorm:"column:last_error"`
+	NextAttemptAt  time.Time      `gorm:"column:next_attempt_at;default:now()"`
+	CreatedAt      time.Time      `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 func (o *Outbox) TableName() string {
@@ -28,4 +33,5 @@ type OutboxList []Outbox
 const (
 	OutboxStatusPending  = "pending"
 	OutboxStatusProduced = "produced"
+	OutboxStatusFailed   = "failed"
 )
@@ -0,0 +1,113 @@
+// Package eventschema declares the schema of every event the services produce. The data
+// of each event is the dto with the same name, e.g. ImageUploaded carries a
+// dto.ImageUploadedEvent, and in Protobuf the eventpb message with the same name, see
+// proto/event/v1/event.proto.
+package eventschema
+
+import (
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema/eventpb"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+)
+
+var (
+	ImageUploaded = eventkit.Schema{
+		Type:    "image.uploaded",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageUploaded { return &eventpb.ImageUploaded{} },
+			converter.DtoImageUploadedEventToEventpbImageUploaded,
+			converter.EventpbImageUploadedToDtoImageUploadedEvent,
+		),
+	}
+
+	ImageUpdated = eventkit.Schema{
+		Type:    "image.updated",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageUpdated { return &eventpb.ImageUpdated{} },
+			converter.DtoImageUpdatedEventToEventpbImageUpdated,
+			converter.EventpbImageUpdatedToDtoImageUpdatedEvent,
+		),
+	}
+
+	ImageDeleted = eventkit.Schema{
+		Type:    "image.deleted",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageDeleted { return &eventpb.ImageDeleted{} },
+			converter.DtoImageDeletedEventToEventpbImageDeleted,
+			converter.EventpbImageDeletedToDtoImageDeletedEvent,
+		),
+	}
+
+	ImageLiked = eventkit.Schema{
+		Type:    "image.liked",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageLiked { return &eventpb.ImageLiked{} },
+			converter.DtoImageLikedEventToEventpbImageLiked,
+			converter.EventpbImageLikedToDtoImageLikedEvent,
+		),
+	}
+
+	ImageUnliked = eventkit.Schema{
+		Type:    "image.unliked",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageUnliked { return &eventpb.ImageUnliked{} },
+			converter.DtoImageUnlikedEventToEventpbImageUnliked,
+			converter.EventpbImageUnlikedToDtoImageUnlikedEvent,
+		),
+	}
+
+	ImageCommented = eventkit.Schema{
+		Type:    "image.commented",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageCommented { return &eventpb.ImageCommented{} },
+			converter.DtoImageCommentedEventToEventpbImageCommented,
+			converter.EventpbImageCommentedToDtoImageCommentedEvent,
+		),
+	}
+
+	ImageCommentDeleted = eventkit.Schema{
+		Type:    "image.comment_deleted",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.ImageCommentDeleted { return &eventpb.ImageCommentDeleted{} },
+			converter.DtoImageCommentDeletedEventToEventpbImageCommentDeleted,
+			converter.EventpbImageCommentDeletedToDtoImageCommentDeletedEvent,
+		),
+	}
+
+	UserFollowed = eventkit.Schema{
+		Type:    "user.followed",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.UserFollowed { return &eventpb.UserFollowed{} },
+			converter.DtoUserFollowedEventToEventpbUserFollowed,
+			converter.EventpbUserFollowedToDtoUserFollowedEvent,
+		),
+	}
+
+	UserUnfollowed = eventkit.Schema{
+		Type:    "user.unfollowed",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.UserUnfollowed { return &eventpb.UserUnfollowed{} },
+			converter.DtoUserUnfollowedEventToEventpbUserUnfollowed,
+			converter.EventpbUserUnfollowedToDtoUserUnfollowedEvent,
+		),
+	}
+
+	Notif = eventkit.Schema{
+		Type:    "notif",
+		Version: 1,
+		Proto: eventkit.NewProtoBinding(
+			func() *eventpb.Notif { return &eventpb.Notif{} },
+			converter.DtoNotifEventToEventpbNotif,
+			converter.EventpbNotifToDtoNotifEvent,
+		),
+	}
+)
@@ -0,0 +1,191 @@
+package eventschema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// update rewrites the golden file of the current version of every schema:
+//
+//	go test ./internal/eventschema/ -update
+//
+// Golden files of older versions are never rewritten, they pin what producers that are
+// still deployed, or records still in retry topics and DLQs, look like.
+var update = flag.Bool("update", false, "rewrite the golden files of the current schema versions")
+
+var at = time.Date(2026, 10, 18, 8, 30, 0, 0, time.UTC)
+
+type contract struct {
+	schema  eventkit.Schema
+	subject string
+	event   any
+	// decode returns a pointer to a zero event of the same dto as event.
+	decode func() any
+}
+
+var contracts = []contract{
+	{
+		schema:  eventschema.ImageUploaded,
+		subject: "1",
+		event: &dto.ImageUploadedEvent{
+			ID: 1, UserID: 2, Caption: "sunset", URL: "http://localhost:9000/image/1.png",
+			CreatedAt: at, UpdatedAt: at,
+		},
+		decode: func() any { return &dto.ImageUploadedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageUpdated,
+		subject: "1",
+		event: &dto.ImageUpdatedEvent{
+			ID: 1, UserID: 2, Caption: "sunrise", URL: "http://localhost:9000/image/1.png",
+			LikeCount: 3, CommentCount: 4, CreatedAt: at, UpdatedAt: at,
+		},
+		decode: func() any { return &dto.ImageUpdatedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageDeleted,
+		subject: "1",
+		event: &dto.ImageDeletedEvent{
+			ID: 1, UserID: 2, Caption: "sunset", URL: "http://localhost:9000/image/1.png",
+			LikeCount: 3, CommentCount: 4, CreatedAt: at, UpdatedAt: at,
+			DeletedAt: gorm.DeletedAt{Time: at, Valid: true},
+		},
+		decode: func() any { return &dto.ImageDeletedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageLiked,
+		subject: "1",
+		event:   &dto.ImageLikedEvent{ID: 3, UserID: 2, ImageID: 1, CreatedAt: at, UpdatedAt: at},
+		decode:  func() any { return &dto.ImageLikedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageUnliked,
+		subject: "1",
+		event: &dto.ImageUnlikedEvent{
+			ID: 3, UserID: 2, ImageID: 1, CreatedAt: at, UpdatedAt: at,
+			DeletedAt: gorm.DeletedAt{Time: at, Valid: true},
+		},
+		decode: func() any { return &dto.ImageUnlikedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageCommented,
+		subject: "1",
+		event:   &dto.ImageCommentedEvent{ID: 4, UserID: 2, ImageID: 1, Comment: "nice", CreatedAt: at, UpdatedAt: at},
+		decode:  func() any { return &dto.ImageCommentedEvent{} },
+	},
+	{
+		schema:  eventschema.ImageCommentDeleted,
+		subject: "1",
+		event: &dto.ImageCommentDeletedEvent{
+			ID: 4, UserID: 2, ImageID: 1, Comment: "nice", CreatedAt: at, UpdatedAt: at,
+			DeletedAt: gorm.DeletedAt{Time: at, Valid: true},
+		},
+		decode: func() any { return &dto.ImageCommentDeletedEvent{} },
+	},
+	{
+		schema:  eventschema.UserFollowed,
+		subject: "2",
+		event:   &dto.UserFollowedEvent{ID: 5, FollowerID: 1, FollowingID: 2, CreatedAt: at, UpdatedAt: at},
+		decode:  func() any { return &dto.UserFollowedEvent{} },
+	},
+	{
+		schema:  eventschema.UserUnfollowed,
+		subject: "2",
+		event: &dto.UserUnfollowedEvent{
+			ID: 5, FollowerID: 1, FollowingID: 2, CreatedAt: at, UpdatedAt: at,
+			DeletedAt: gorm.DeletedAt{Time: at, Valid: true},
+		},
+		decode: func() any { return &dto.UserUnfollowedEvent{} },
+	},
+	{
+		schema:  eventschema.Notif,
+		subject: "2",
+		event:   &dto.NotifEvent{UserID: 2, Message: "someone followed you"},
+		decode:  func() any { return &dto.NotifEvent{} },
+	},
+}
+
+// codecs are the codecs with golden files, by file extension.
+var codecs = map[string]eventkit.Codec{
+	"json": eventkit.JSON,
+	"pb":   eventkit.Protobuf,
+}
+
+func goldenPath(schema eventkit.Schema, version int, ext string) string {
+	return filepath.Join("testdata", fmt.Sprintf("%s.v%d.%s", schema.Type, version, ext))
+}
+
+func TestContract_ProducerMatchesGolden(t *testing.T) {
+	for _, c := range contracts {
+		for ext, codec := range codecs {
+			t.Run(c.schema.Type+"."+ext, func(t *testing.T) {
+				got, err := c.schema.Marshal(codec, "00000000-0000-0000-0000-000000000001", "golang-clean-architecture", c.subject, at, c.event)
+				require.NoError(t, err)
+				if codec == eventkit.JSON {
+					indented := bytes.Buffer{}
+					require.NoError(t, json.Indent(&indented, got, "", "  "))
+					got = append(indented.Bytes(), '\n')
+				}
+
+				path := goldenPath(c.schema, c.schema.Version, ext)
+				if *update {
+					require.NoError(t, os.WriteFile(path, got, 0o644))
+				}
+
+				want, err := os.ReadFile(path)
+				require.NoError(t, err, "run with -update to create the golden file")
+				if codec == eventkit.JSON {
+					require.JSONEq(t, string(want), string(got), "schema changed, bump %s Version and add an upcaster", c.schema.Type)
+				} else {
+					require.Equal(t, want, got, "schema changed, keep %s wire compatible", c.schema.Type)
+				}
+			})
+		}
+	}
+}
+
+func TestContract_ConsumerDecodesEveryGoldenVersion(t *testing.T) {
+	for _, c := range contracts {
+		for ext, codec := range codecs {
+			t.Run(c.schema.Type+"."+ext, func(t *testing.T) {
+				for version := eventkit.LegacyVersion; version <= c.schema.Version; version++ {
+					value, err := os.ReadFile(goldenPath(c.schema, version, ext))
+					require.NoError(t, err)
+
+					got := c.decode()
+					envelope, err := c.schema.Unmarshal(codec, value, got)
+					require.NoError(t, err, "version %d", version)
+					require.Equal(t, c.schema.Version, envelope.SchemaVersion)
+					require.Equal(t, c.event, got, "version %d", version)
+				}
+			})
+		}
+	}
+}
+
+func TestContract_ConsumerDecodesLegacyPayloadWithoutEnvelope(t *testing.T) {
+	for _, c := range contracts {
+		t.Run(c.schema.Type, func(t *testing.T) {
+			// before the envelope, producers wrote the bare dto
+			value, err := json.Marshal(c.event)
+			require.NoError(t, err)
+
+			got := c.decode()
+			_, err = c.schema.Unmarshal(eventkit.JSON, value, got)
+			require.NoError(t, err)
+			require.Equal(t, c.event, got)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+
+1.0$00000000-0000-0000-0000-000000000001golang-clean-architecture"image.unliked(21:Bapplication/protobufJ"*2
\ No newline at end of file
@@ -0,0 +1,2 @@
+
+1.0$00000000-0000-0000-0000-000000000001golang-clean-architecture"image.updated(21:Bapplication/protobufJDsunrise"!http://localhost:9000/image/1.png(0:B
\ No newline at end of file
@@ -0,0 +1,2 @@
+
+1.0$00000000-0000-0000-0000-000000000001golang-clean-architecture"image.uploaded(21:Bapplication/protobufJ?sunset"!http://localhost:9000/image/1.png:B
\ No newline at end of file
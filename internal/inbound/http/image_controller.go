@@ -61,6 +61,82 @@ func (c *ImageController) Upload(ctx *fiber.Ctx) error {
 	return response.Data(ctx, http.StatusOK, res)
 }
 
+// UpdateImage godoc
+//
+//	@Summary		Update image
+//	@Description	Update the caption of an image of the current user
+//	@Tags			images
+//	@Accept			json
+//	@Produce		json
+//	@Param			imageId	path	int						true	"Image ID"
+//	@Param			request	body	dto.UpdateImageRequest	true	"Update Image Request"
+//	@Security		SimpleApiKeyAuth
+//	@Success		200	{object}	response.WebResponse[dto.ImageResponse]
+//	@Router			/api/images/{imageId} [patch]
+func (c *ImageController) UpdateImage(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	imageID, err := strconv.ParseInt(ctx.Params("imageId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateImage")
+	}
+
+	req := dto.UpdateImageRequest{}
+	err = ctx.BodyParser(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateImage")
+	}
+
+	req.ID = imageID
+
+	res, err := c.Usecase.UpdateImage(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateImage")
+	}
+
+	return response.Data(ctx, http.StatusOK, res)
+}
+
+// DeleteImage godoc
+//
+//	@Summary		Delete image
+//	@Description	Delete an image of the current user with its likes and comments
+//	@Tags			images
+//	@Produce		json
+//	@Param			imageId	path	int	true	"Image ID"
+//	@Security		SimpleApiKeyAuth
+//	@Success		200	{object}	response.WebResponse[string]
+//	@Router			/api/images/{imageId} [delete]
+func (c *ImageController) DeleteImage(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	imageID, err := strconv.ParseInt(ctx.Params("imageId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).DeleteImage")
+	}
+
+	req := dto.DeleteImageRequest{
+		ID: imageID,
+	}
+
+	err = c.Usecase.DeleteImage(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).DeleteImage")
+	}
+
+	return response.Data(ctx, http.StatusOK, "ok")
+}
+
 // Like godoc
 //
 //	@Summary		Like image
@@ -93,6 +169,40 @@ func (c *ImageController) Like(ctx *fiber.Ctx) error {
 	return response.Data(ctx, http.StatusOK, "ok")
 }
 
+// Unlike godoc
+//
+//	@Summary		Unlike image
+//	@Description	Remove the like of the current user from an image
+//	@Tags			images
+//	@Produce		json
+//	@Param			imageId	path	int	true	"Image ID"
+//	@Security		SimpleApiKeyAuth
+//	@Success		200	{object}	response.WebResponse[string]
+//	@Router			/api/images/{imageId}/likes [delete]
+func (c *ImageController) Unlike(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	imageID, err := strconv.ParseInt(ctx.Params("imageId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).Unlike")
+	}
+
+	req := dto.UnlikeImageRequest{
+		ImageID: imageID,
+	}
+
+	err = c.Usecase.Unlike(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).Unlike")
+	}
+
+	return response.Data(ctx, http.StatusOK, "ok")
+}
+
 // Comment godoc
 //
 //	@Summary		Comment image
@@ -125,6 +235,100 @@ func (c *ImageController) Comment(ctx *fiber.Ctx) error {
 	return response.Data(ctx, http.StatusOK, "ok")
 }
 
+// UpdateComment godoc
+//
+//	@Summary		Update image comment
+//	@Description	Edit a comment of the current user on an image
+//	@Tags			images
+//	@Accept			json
+//	@Produce		json
+//	@Param			imageId		path	int							true	"Image ID"
+//	@Param			commentId	path	int							true	"Comment ID"
+//	@Param			request		body	dto.UpdateCommentRequest	true	"Update Comment Request"
+//	@Security		SimpleApiKeyAuth
+//	@Success		200	{object}	response.WebResponse[dto.CommentResponse]
+//	@Router			/api/images/{imageId}/comments/{commentId} [patch]
+func (c *ImageController) UpdateComment(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	imageID, err := strconv.ParseInt(ctx.Params("imageId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateComment")
+	}
+
+	commentID, err := strconv.ParseInt(ctx.Params("commentId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateComment")
+	}
+
+	req := dto.UpdateCommentRequest{}
+	err = ctx.BodyParser(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateComment")
+	}
+
+	req.ImageID = imageID
+	req.CommentID = commentID
+
+	res, err := c.Usecase.UpdateComment(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).UpdateComment")
+	}
+
+	return response.Data(ctx, http.StatusOK, res)
+}
+
+// DeleteComment godoc
+//
+//	@Summary		Delete image comment
+//	@Description	Delete a comment on an image, by the commenter or the image owner
+//	@Tags			images
+//	@Produce		json
+//	@Param			imageId		path	int	true	"Image ID"
+//	@Param			commentId	path	int	true	"Comment ID"
+//	@Security		SimpleApiKeyAuth
+//	@Success		200	{object}	response.WebResponse[string]
+//	@Router			/api/images/{imageId}/comments/{commentId} [delete]
+func (c *ImageController) DeleteComment(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	imageID, err := strconv.ParseInt(ctx.Params("imageId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).DeleteComment")
+	}
+
+	commentID, err := strconv.ParseInt(ctx.Params("commentId"), 10, 64)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).DeleteComment")
+	}
+
+	req := dto.DeleteCommentRequest{
+		ImageID:   imageID,
+		CommentID: commentID,
+	}
+
+	err = c.Usecase.DeleteComment(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*ImageController).DeleteComment")
+	}
+
+	return response.Data(ctx, http.StatusOK, "ok")
+}
+
 // GetLike godoc
 //
 //	@Summary		Get image likes
@@ -45,14 +45,20 @@ func setupAuthRoute(router fiber.Router, controllers *dependency_injection.Contr
 		users.Patch("/_current", controllers.UserController.Update)
 		users.Get("/_current", controllers.UserController.Current)
 		users.Post("/_follow", controllers.UserController.Follow)
+		users.Post("/_unfollow", controllers.UserController.Unfollow)
 	}
 
 	images := router.Group("/images")
 	{
 		images.Post("", controllers.ImageController.Upload)
+		images.Patch("/:imageId", controllers.ImageController.UpdateImage)
+		images.Delete("/:imageId", controllers.ImageController.DeleteImage)
 		images.Post("/_like", controllers.ImageController.Like)
 		images.Post("/_comment", controllers.ImageController.Comment)
 		images.Get("/:imageId/likes", controllers.ImageController.GetLike)
+		images.Delete("/:imageId/likes", controllers.ImageController.Unlike)
 		images.Get("/:imageId/comments", controllers.ImageController.GetComment)
+		images.Patch("/:imageId/comments/:commentId", controllers.ImageController.UpdateComment)
+		images.Delete("/:imageId/comments/:commentId", controllers.ImageController.DeleteComment)
 	}
 }
@@ -173,3 +173,33 @@ func (c *UserController) Follow(ctx *fiber.Ctx) error {
 
 	return response.Data(ctx, http.StatusOK, "ok")
 }
+
+// Unfollow godoc
+//
+//	@Summary		Unfollow user
+//	@Description	Unfollow a user
+//	@Tags			users
+//	@Security		SimpleApiKeyAuth
+//	@Param			request	body		dto.UnfollowUserRequest	true	"Unfollow User Request"
+//	@Success		200		{object}	response.WebResponse[string]
+//	@Router			/api/users/_unfollow [post]
+func (c *UserController) Unfollow(ctx *fiber.Ctx) error {
+	span := telemetry.StartController(ctx)
+	defer span.End()
+
+	req := dto.UnfollowUserRequest{}
+	err := ctx.BodyParser(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*UserController).Unfollow")
+	}
+
+	err = c.Usecase.Unfollow(ctx.UserContext(), req)
+	if err != nil {
+		logkit.Logger.WithContext(ctx.UserContext()).WithError(err).Error()
+		return errkit.AddFuncName(err, "http.(*UserController).Unfollow")
+	}
+
+	return response.Data(ctx, http.StatusOK, "ok")
+}
@@ -0,0 +1,52 @@
+package messaging
+
+import (
+	"context"
+)
+
+// BatchResult holds the outcome of every record passed to a ConsumerHandlerBatch, at the
+// same index as the record. A nil entry means the record succeeded, an entry wrapped with
+// errkit.WrapNonRetryable sends the record to the DLQ, and any other error sends it to the
+// retry topic.
+type BatchResult []error
+
+func NewBatchResult(size int) BatchResult {
+	return make(BatchResult, size)
+}
+
+// Err returns the first error in the result, or nil when every record succeeded.
+func (r BatchResult) Err() error {
+	for _, err := range r {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyBatch calls apply with all events at once. When that fails it calls apply again for
+// each event on its own, so the returned errors, aligned with events, only blame the events
+// that actually fail. apply must be all-or-nothing, otherwise events of a failed batch would
+// be applied twice.
+func applyBatch[S ~[]E, E any](ctx context.Context, events S, apply func(ctx context.Context, events S) error) []error {
+	errs := make([]error, len(events))
+	if len(events) == 0 {
+		return errs
+	}
+
+	err := apply(ctx, events)
+	if err == nil {
+		return errs
+	}
+
+	if len(events) == 1 {
+		errs[0] = err
+		return errs
+	}
+
+	for i := range events {
+		errs[i] = apply(ctx, events[i:i+1])
+	}
+
+	return errs
+}
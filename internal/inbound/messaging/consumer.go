@@ -2,107 +2,285 @@ package messaging
 
 import (
 	"context"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
 	"github.com/sirupsen/logrus"
 	"github.com/twmb/franz-go/pkg/kgo"
 )
 
-type ConsumerHandlerBatch func(ctx context.Context, records []*kgo.Record) error
+type ConsumerHandlerBatch func(ctx context.Context, records []*kgo.Record) BatchResult
 
 type ConsumerHandlerSingle func(ctx context.Context, record *kgo.Record) error
 
 func parseRetryCount(record *kgo.Record) int {
-	result := 0
-	for _, h := range record.Headers {
-		if h.Key == "x-retry-count" {
-			n, err := strconv.Atoi(string(h.Value))
-			if err == nil {
-				result = n
-			}
-		}
+	value, _ := headerValue(record, header.RetryCount)
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
 	}
-	return result
+	return n
 }
 
-func produceToRetry(ctx context.Context, producer *kgo.Client, _topic topic.Topic, record *kgo.Record, retryCount int) {
-	record.Topic = _topic.Retry()
+// retryTier returns the tier a record goes to on its retryCount-th retry. Once past the
+// last tier, records keep going to the last tier until max retries is reached.
+func retryTier(retryDelays []time.Duration, retryCount int) int {
+	return max(1, min(retryCount, len(retryDelays)))
+}
 
-	filtered := make([]kgo.RecordHeader, 0, len(record.Headers))
-	for _, h := range record.Headers {
-		if h.Key != "x-retry-count" {
-			filtered = append(filtered, h)
-		}
+func retryDelays(cfg *config.Config) []time.Duration {
+	seconds := cfg.GetKafkaConsumerRetryDelaysSeconds()
+	delays := make([]time.Duration, len(seconds))
+	for i, s := range seconds {
+		delays[i] = time.Duration(s) * time.Second
+	}
+	return delays
+}
+
+func parseRetryDueAt(record *kgo.Record) time.Time {
+	value, _ := headerValue(record, header.RetryDueAt)
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// waitUntilDue blocks until the record's retry due time. It returns false if ctx is done first.
+func waitUntilDue(ctx context.Context, record *kgo.Record) bool {
+	wait := time.Until(parseRetryDueAt(record))
+	if wait <= 0 {
+		return true
 	}
-	filtered = append(filtered, kgo.RecordHeader{
-		Key:   "x-retry-count",
-		Value: []byte(strconv.Itoa(retryCount)),
-	})
-	record.Headers = filtered
 
-	result := producer.ProduceSync(ctx, record)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// routedRecord copies record to be produced to a retry or DLQ topic, so the consumed record
+// keeps its own position and can still be committed once routed. The timestamp is left
+// for the producer to set, so a DLQ record is timestamped when it was dead-lettered.
+func routedRecord(record *kgo.Record) *kgo.Record {
+	return &kgo.Record{
+		Key:       record.Key,
+		Value:     record.Value,
+		Headers:   slices.Clone(record.Headers),
+		Topic:     record.Topic,
+		Partition: record.Partition,
+		Offset:    record.Offset,
+		Context:   record.Context,
+	}
+}
+
+// produceToRetry produces record to the retry tier of its retryCount-th retry. It is
+// produced even once ctx is done, so a handler failed by shutdown is still routed before its
+// record is committed.
+func produceToRetry(ctx context.Context, producer *kgo.Client, retryDelays []time.Duration, _topic topic.Topic, consumerGroup string, record *kgo.Record, retryCount int, cause error) error {
+	routed := routedRecord(record)
+	setFailureHeaders(routed, consumerGroup, cause)
+
+	tier := retryTier(retryDelays, retryCount)
+	dueAt := time.Now().Add(retryDelays[tier-1])
+	routed.Topic = _topic.Retry(tier)
+
+	setHeader(routed, header.RetryCount, strconv.Itoa(retryCount))
+	setHeader(routed, header.RetryDueAt, strconv.FormatInt(dueAt.UnixMilli(), 10))
+
+	result := producer.ProduceSync(context.WithoutCancel(ctx), routed)
 	if err := result.FirstErr(); err != nil {
 		logkit.Logger.WithContext(ctx).WithError(err).
-			WithField("retryTopic", _topic.Retry()).
+			WithField("retryTopic", routed.Topic).
 			WithField("retryCount", retryCount).
 			Error("produceToRetry: failed to produce")
+		return errkit.AddFuncName(err, "messaging.produceToRetry")
 	}
+
+	return nil
 }
 
-func produceToDLQ(ctx context.Context, producer *kgo.Client, _topic topic.Topic, record *kgo.Record) {
-	record.Topic = _topic.DLQ()
+// produceToDLQ produces record to the DLQ topic, even once ctx is done, see produceToRetry.
+func produceToDLQ(ctx context.Context, producer *kgo.Client, _topic topic.Topic, consumerGroup string, record *kgo.Record, cause error) error {
+	routed := routedRecord(record)
+	setFailureHeaders(routed, consumerGroup, cause)
+
+	routed.Topic = _topic.DLQ()
 
-	result := producer.ProduceSync(ctx, record)
+	result := producer.ProduceSync(context.WithoutCancel(ctx), routed)
 	if err := result.FirstErr(); err != nil {
 		logkit.Logger.WithContext(ctx).WithError(err).
 			WithField("dlqTopic", _topic.DLQ()).
 			Error("produceToDLQ: failed to produce")
+		return errkit.AddFuncName(err, "messaging.produceToDLQ")
+	}
+
+	return nil
+}
+
+// routeFailed calls route until it routes the failed record to its retry or DLQ topic,
+// waiting with backoff between attempts, and returns false if ctx is done first. The later
+// records of the partition of record wait meanwhile, so the partition is counted by
+// telemetry.AddStoppedPartition until the record is routed or given up.
+func routeFailed(ctx context.Context, cfg *config.Config, consumerGroup string, record *kgo.Record, localLogger *logrus.Entry, route func() error) bool {
+	err := route()
+	if err == nil {
+		return true
 	}
+
+	metricCtx := context.WithoutCancel(ctx)
+	telemetry.AddStoppedPartition(metricCtx, consumerGroup, record.Topic, 1)
+	defer telemetry.AddStoppedPartition(metricCtx, consumerGroup, record.Topic, -1)
+
+	backoff := time.Duration(cfg.GetKafkaConsumerRouteBackoffSeconds()) * time.Second
+	maxBackoff := time.Duration(cfg.GetKafkaConsumerRouteMaxBackoffSeconds()) * time.Second
+	for attempts := 1; ; attempts++ {
+		wait := routeBackoff(attempts, backoff, maxBackoff)
+		localLogger.WithError(err).WithFields(logrus.Fields{
+			"partition": record.Partition,
+			"offset":    record.Offset,
+			"attempts":  attempts,
+			"retryIn":   wait.String(),
+		}).Error("failed message not routed, routing it again before the rest of its partition")
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		}
+
+		err = route()
+		if err == nil {
+			return true
+		}
+	}
+}
+
+// routeBackoff returns backoff doubled for every attempt after the first, capped at maxBackoff.
+func routeBackoff(attempts int, backoff time.Duration, maxBackoff time.Duration) time.Duration {
+	const maxShift = 30
+	shift := min(attempts-1, maxShift)
+	d := backoff << shift
+	if d <= 0 || d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+// primaryTopics returns the primary topic names of topics, and the topic of each name.
+func primaryTopics(topics []topic.Topic) ([]string, map[string]topic.Topic) {
+	names := []string{}
+	byName := map[string]topic.Topic{}
+	for _, _topic := range topics {
+		names = append(names, _topic.Primary)
+		byName[_topic.Primary] = _topic
+	}
+	return names, byName
 }
 
-func ConsumeEventBatch(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, _topic topic.Topic, handler ConsumerHandlerBatch) {
+// retryTopics returns the topic names of the retry tiers of topics, and the topic of each
+// name.
+func retryTopics(topics []topic.Topic, tiers int) ([]string, map[string]topic.Topic) {
+	names := []string{}
+	byName := map[string]topic.Topic{}
+	for _, _topic := range topics {
+		for tier := 1; tier <= tiers; tier++ {
+			names = append(names, _topic.Retry(tier))
+			byName[_topic.Retry(tier)] = _topic
+		}
+	}
+	return names, byName
+}
+
+// ConsumeEventBatch calls handler with every record of a poll of topics at once, and commits
+// each partition past its last record. A failed record is routed to the retry or DLQ topic
+// before the batch is committed, see routeFailed, and on shutdown a partition is only
+// committed up to its first failed record not routed yet.
+func ConsumeEventBatch(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, topics []topic.Topic, handler ConsumerHandlerBatch) {
+	names, byName := primaryTopics(topics)
+
 	localLogger := logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
 		"consumerGroup": consumerGroup,
-		"topic":         _topic.Primary,
+		"topic":         names,
 	})
 
 	localLogger.Info("setup kafka client")
 
-	client := provider.NewKafkaClientConsumerBatch(cfg, consumerGroup, _topic.Primary)
+	client := provider.NewKafkaClientConsumerBatch(cfg, consumerGroup, names...)
+
+	retryDelays := retryDelays(cfg)
 
 	for {
 		const maxPollRecords = 0 // returns all buffered records
 		fetches := client.PollRecords(ctx, maxPollRecords)
 
 		if errs := fetches.Errors(); len(errs) > 0 {
+			client.AllowRebalance()
 			for _, err := range errs {
 				localLogger.WithError(err.Err).Error("error client poll fetch")
 			}
+			if ctx.Err() != nil {
+				localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
+				break
+			}
 			continue
 		}
 
 		records := fetches.Records()
 		if len(records) > 0 {
-			err := handler(ctx, records)
-			if err != nil {
-				localLogger.WithError(err).Error("handler got error processing message")
-				// TODO: improve by knowing which individual record failed in the batch,
-				//       so only failed records go to retry
-				for _, record := range records {
-					produceToRetry(ctx, producer, _topic, record, 1)
+			result := handler(ctx, records)
+
+			// records come in offset order per partition, once a failed record of a
+			// partition is not routed none of its records from it on is committed
+			notRouted := map[topicPartition]bool{}
+			commit := []*kgo.Record{}
+			for i, record := range records {
+				tp := topicPartition{topic: record.Topic, partition: record.Partition}
+				if notRouted[tp] {
+					continue
+				}
+
+				err := result[i]
+				if err != nil {
+					localLogger.WithError(err).WithField("offset", record.Offset).Error("handler got error processing message in batch")
+
+					_topic := byName[record.Topic]
+					ok := routeFailed(ctx, cfg, consumerGroup, record, localLogger, func() error {
+						if errkit.IsNonRetryable(err) {
+							return produceToDLQ(ctx, producer, _topic, consumerGroup, record, err)
+						}
+						return produceToRetry(ctx, producer, retryDelays, _topic, consumerGroup, record, 1, err)
+					})
+					if !ok {
+						localLogger.WithField("offset", record.Offset).Error("failed message not routed before the consumer stopped, leaving the rest of its partition uncommitted")
+						notRouted[tp] = true
+						continue
+					}
 				}
+
+				commit = append(commit, record)
 			}
 
-			err = client.CommitUncommittedOffsets(ctx)
-			if err != nil {
-				localLogger.WithError(err).Error("client error commit")
+			if len(commit) > 0 {
+				commitRecords(ctx, client, localLogger, commit...)
 			}
 		}
+		client.AllowRebalance()
 
 		if ctx.Err() != nil {
 			localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
@@ -115,45 +293,78 @@ func ConsumeEventBatch(ctx context.Context, cfg *config.Config, producer *kgo.Cl
 	localLogger.Info("Done closing consumer")
 }
 
-func ConsumeEventSingle(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, _topic topic.Topic, handler ConsumerHandlerSingle) {
+// ConsumeEventSingle calls handler with one record at a time per partition, each partition
+// being processed in its own goroutine and committed once its polled records are processed,
+// see partitionRunner. A failed record is routed to the retry or DLQ topic before the next
+// record of its partition, see routeFailed. On shutdown the records not processed yet are
+// left uncommitted, and so is a failed record not routed yet.
+// handler is expected to enforce its own deadline, recover its panics and bound how many
+// handlers run at once, see Registry.
+func ConsumeEventSingle(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, topics []topic.Topic, handler ConsumerHandlerSingle) {
+	names, byName := primaryTopics(topics)
+
 	localLogger := logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
 		"consumerGroup": consumerGroup,
-		"topic":         _topic.Primary,
+		"topic":         names,
 	})
 
 	localLogger.Info("setup kafka client")
 
-	client := provider.NewKafkaClientConsumerSingle(cfg, consumerGroup, _topic.Primary)
+	runner := newPartitionRunner()
+	client := provider.NewKafkaClientConsumerSingle(cfg, consumerGroup, names, runner.revoked)
+
+	retryDelays := retryDelays(cfg)
+
+	maxPollRecords := cfg.GetKafkaConsumerMaxPollRecords()
 
 	for {
-		const maxPollRecords = 1 // returns a maximum 1 record.
 		fetches := client.PollRecords(ctx, maxPollRecords)
 		if errs := fetches.Errors(); len(errs) > 0 {
+			client.AllowRebalance()
 			for _, err := range errs {
 				localLogger.WithError(err.Err).Error("error client poll fetch")
 			}
+			if ctx.Err() != nil {
+				localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
+				break
+			}
 			continue
 		}
 
-		records := fetches.Records()
-		if len(records) > 0 {
-			record := records[0]
-			err := handler(ctx, record)
-			if err != nil {
-				localLogger.WithError(err).Error("handler got error processing message")
-
-				if errkit.IsNonRetryable(err) {
-					produceToDLQ(ctx, producer, _topic, record)
-				} else {
-					produceToRetry(ctx, producer, _topic, record, parseRetryCount(record)+1)
+		runner.dispatch(ctx, client, fetches, func(partitionCtx context.Context, records []*kgo.Record) bool {
+			var last *kgo.Record
+			routed := true
+			for _, record := range records {
+				if partitionCtx.Err() != nil {
+					break
+				}
+
+				err := handler(ctx, record)
+				if err != nil {
+					localLogger.WithError(err).Error("handler got error processing message")
+
+					_topic := byName[record.Topic]
+					routed = routeFailed(partitionCtx, cfg, consumerGroup, record, localLogger, func() error {
+						if errkit.IsNonRetryable(err) {
+							return produceToDLQ(ctx, producer, _topic, consumerGroup, record, err)
+						}
+						return produceToRetry(ctx, producer, retryDelays, _topic, consumerGroup, record, parseRetryCount(record)+1, err)
+					})
+					if !routed {
+						localLogger.WithField("offset", record.Offset).Error("failed message not routed before the partition was revoked or the consumer stopped, leaving it uncommitted")
+						break
+					}
 				}
+
+				last = record
 			}
 
-			err = client.CommitUncommittedOffsets(ctx)
-			if err != nil {
-				localLogger.WithError(err).Error("client error commit")
+			if last != nil {
+				commitRecords(ctx, client, localLogger, last)
 			}
-		}
+			return routed
+		})
+		client.AllowRebalance()
 
 		if ctx.Err() != nil {
 			localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
@@ -161,57 +372,150 @@ func ConsumeEventSingle(ctx context.Context, cfg *config.Config, producer *kgo.C
 		}
 	}
 
+	runner.wait()
+
 	localLogger.Info("Start closing consumer")
 	client.Close()
 	localLogger.Info("Done closing consumer")
 }
 
-func ConsumeEventRetry(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, _topic topic.Topic, handler ConsumerHandlerSingle) {
+// ConsumeEventRetry consumes every retry tier of topics with a single client. Partitions are
+// processed as in ConsumeEventSingle, each in its own goroutine, so a long tier never holds
+// back a short one.
+func ConsumeEventRetry(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumerGroup string, topics []topic.Topic, handler ConsumerHandlerSingle) {
+	names, byName := retryTopics(topics, len(cfg.GetKafkaConsumerRetryDelaysSeconds()))
+
 	localLogger := logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
 		"consumerGroup": consumerGroup,
-		"topic":         _topic.Retry(),
+		"topic":         names,
 	})
 
 	localLogger.Info("setup kafka client")
 
-	client := provider.NewKafkaClientConsumerSingle(cfg, consumerGroup, _topic.Retry())
+	runner := newPartitionRunner()
+	client := provider.NewKafkaClientConsumerSingle(cfg, consumerGroup, names, runner.revoked)
 
 	maxRetries := cfg.GetKafkaConsumerMaxRetries()
+	retryDelays := retryDelays(cfg)
+
+	maxPollRecords := cfg.GetKafkaConsumerMaxPollRecords()
 
 	for {
-		const maxPollRecords = 1 // returns a maximum 1 record.
 		fetches := client.PollRecords(ctx, maxPollRecords)
 		if errs := fetches.Errors(); len(errs) > 0 {
+			client.AllowRebalance()
 			for _, err := range errs {
 				localLogger.WithError(err.Err).Error("error client poll fetch")
 			}
+			if ctx.Err() != nil {
+				localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
+				break
+			}
+			continue
+		}
+
+		runner.dispatch(ctx, client, fetches, func(partitionCtx context.Context, records []*kgo.Record) bool {
+			var last *kgo.Record
+			routed := true
+			for _, record := range records {
+				// records of one tier share the same delay, so they become due in offset order
+				if !waitUntilDue(partitionCtx, record) {
+					localLogger.Info("context cancelled or partition revoked before retry was due, leaving record uncommitted")
+					break
+				}
+
+				err := handler(ctx, record)
+				if err != nil {
+					localLogger.WithError(err).Error("handler got error processing message")
+
+					_topic := byName[record.Topic]
+					routed = routeFailed(partitionCtx, cfg, consumerGroup, record, localLogger, func() error {
+						retryCount := parseRetryCount(record)
+						if errkit.IsNonRetryable(err) || retryCount >= maxRetries {
+							return produceToDLQ(ctx, producer, _topic, consumerGroup, record, err)
+						}
+						return produceToRetry(ctx, producer, retryDelays, _topic, consumerGroup, record, retryCount+1, err)
+					})
+					if !routed {
+						localLogger.WithField("offset", record.Offset).Error("failed message not routed before the partition was revoked or the consumer stopped, leaving it uncommitted")
+						break
+					}
+				}
+
+				last = record
+			}
+
+			if last != nil {
+				commitRecords(ctx, client, localLogger, last)
+			}
+			return routed
+		})
+		client.AllowRebalance()
+
+		if ctx.Err() != nil {
+			localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
+			break
+		}
+	}
+
+	runner.wait()
+
+	localLogger.Info("Start closing consumer")
+	client.Close()
+	localLogger.Info("Done closing consumer")
+}
+
+// ConsumeEventDLQ logs every dead letter of topics with its failure headers, so they show up
+// in logs and alerts. It never reprocesses them, use cmd/dlq to inspect and replay.
+func ConsumeEventDLQ(ctx context.Context, cfg *config.Config, consumerGroup string, topics []topic.Topic) {
+	dlqTopics := []string{}
+	for _, _topic := range topics {
+		dlqTopics = append(dlqTopics, _topic.DLQ())
+	}
+
+	localLogger := logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
+		"consumerGroup": consumerGroup,
+		"topic":         dlqTopics,
+	})
+
+	localLogger.Info("setup kafka client")
+
+	client := provider.NewKafkaClientConsumerBatch(cfg, consumerGroup, dlqTopics...)
+
+	for {
+		const maxPollRecords = 0 // returns all buffered records
+		fetches := client.PollRecords(ctx, maxPollRecords)
+		if errs := fetches.Errors(); len(errs) > 0 {
+			client.AllowRebalance()
+			for _, err := range errs {
+				localLogger.WithError(err.Err).Error("error client poll fetch")
+			}
+			if ctx.Err() != nil {
+				localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
+				break
+			}
 			continue
 		}
 
 		records := fetches.Records()
 		if len(records) > 0 {
-			record := records[0]
-			err := handler(ctx, record)
-			if err != nil {
-				localLogger.WithError(err).Error("handler got error processing message")
-
-				if errkit.IsNonRetryable(err) {
-					produceToDLQ(ctx, producer, _topic, record)
-				} else {
-					retryCount := parseRetryCount(record)
-					if retryCount >= maxRetries {
-						produceToDLQ(ctx, producer, _topic, record)
-					} else {
-						produceToRetry(ctx, producer, _topic, record, retryCount+1)
+			for _, record := range records {
+				fields := logrus.Fields{
+					"dlqTopic":  record.Topic,
+					"partition": record.Partition,
+					"offset":    record.Offset,
+				}
+				for _, key := range header.Failure {
+					if value, ok := headerValue(record, key); ok {
+						fields[key] = value
 					}
 				}
+				localLogger.WithFields(fields).Error("dead letter received")
 			}
 
-			err = client.CommitUncommittedOffsets(ctx)
-			if err != nil {
-				localLogger.WithError(err).Error("client error commit")
-			}
+			commitRecords(ctx, client, localLogger, records...)
 		}
+		client.AllowRebalance()
 
 		if ctx.Err() != nil {
 			localLogger.WithError(ctx.Err()).Info("context cancelled, stopping consumer")
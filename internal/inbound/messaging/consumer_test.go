@@ -0,0 +1,331 @@
+package messaging_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestConsumeEventRetry_WaitsForTierDelay(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.retry-tiers"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.Retry(2), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerMaxRetries, 3)
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1, 2})
+
+	type call struct {
+		topic         string
+		originalTopic string
+		at            time.Time
+	}
+	mu := sync.Mutex{}
+	calls := []call{}
+	done := make(chan struct{})
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		mu.Lock()
+		defer mu.Unlock()
+		originalTopic := ""
+		for _, h := range record.Headers {
+			if h.Key == header.OriginalTopic {
+				originalTopic = string(h.Value)
+			}
+		}
+		calls = append(calls, call{topic: record.Topic, originalTopic: originalTopic, at: time.Now()})
+		if len(calls) < 3 {
+			return assert.AnError
+		}
+		close(done)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	wg.Go(func() {
+		messaging.ConsumeEventRetry(ctx, cfg, producer, "test.group.retry", []topic.Topic{_topic}, handler)
+	})
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte(`{}`)}).FirstErr()
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("record was not retried in time")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, calls, 3)
+	require.Equal(t, _topic.Primary, calls[0].topic)
+	require.Equal(t, _topic.Retry(1), calls[1].topic)
+	require.Equal(t, _topic.Retry(2), calls[2].topic)
+	require.Equal(t, _topic.Primary, calls[2].originalTopic)
+	// due times are stored with millisecond precision
+	require.GreaterOrEqual(t, calls[1].at.Sub(calls[0].at), 1*time.Second-time.Millisecond)
+	require.GreaterOrEqual(t, calls[2].at.Sub(calls[1].at), 2*time.Second-time.Millisecond)
+}
+
+func TestConsumeEventSingle_DLQRecordCarriesFailureCause(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.failure-cause"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		return errkit.WrapNonRetryable(assert.AnError)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	before := time.Now().UTC()
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte(`{}`)}).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+
+	headers := map[string]string{}
+	for _, h := range records[0].Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	require.Contains(t, headers[header.ErrorMessage], assert.AnError.Error())
+	require.Equal(t, header.ErrorClassNonRetryable, headers[header.ErrorClass])
+	require.Equal(t, "test.group", headers[header.ErrorConsumerGroup])
+	require.Equal(t, _topic.Primary, headers[header.OriginalTopic])
+	require.Equal(t, "0", headers[header.OriginalPartition])
+	require.Equal(t, "0", headers[header.OriginalOffset])
+
+	firstFailureAt, err := time.Parse(time.RFC3339Nano, headers[header.FirstFailureAt])
+	require.NoError(t, err)
+	require.WithinDuration(t, before, firstFailureAt, 30*time.Second)
+}
+
+func TestConsumeEventSingle_DLQRecordIsTimestampedWhenDeadLettered(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.dlq-timestamp"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		return errkit.WrapNonRetryable(assert.AnError)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	before := time.Now()
+	produced := before.Add(-24 * time.Hour)
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Timestamp: produced, Value: []byte(`{}`)}).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+
+	require.False(t, records[0].Timestamp.Before(before.Truncate(time.Millisecond)))
+}
+
+func TestConsumeEventBatch_CommitsEveryPartition(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.batch-commit"}
+	cfg, producer := newFakeKafkaPartitions(t, 2, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	handler := func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		return messaging.NewBatchResult(len(records))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventBatch(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Partition: 0, Value: []byte("1")},
+		&kgo.Record{Topic: _topic.Primary, Partition: 1, Value: []byte("2")},
+		&kgo.Record{Topic: _topic.Primary, Partition: 1, Value: []byte("3")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	admin := kadm.NewClient(producer)
+	require.Eventually(t, func() bool {
+		offsets, err := admin.FetchOffsets(context.Background(), "test.group")
+		if err != nil {
+			return false
+		}
+		o0, ok0 := offsets.Lookup(_topic.Primary, 0)
+		o1, ok1 := offsets.Lookup(_topic.Primary, 1)
+		return ok0 && ok1 && o0.At == 1 && o1.At == 2
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestConsumeEventBatch_FailedRecordIsRoutedBeforeCommit(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.batch-route"}
+	// the DLQ topic is missing, so routing to it fails until it is created
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1)})
+	cfg.Set(config.KafkaConsumerRouteBackoffSeconds, 1)
+
+	routingProducer, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.GetKafkaBootstrapServers()),
+		kgo.UnknownTopicRetries(0),
+	)
+	require.NoError(t, err)
+	defer routingProducer.Close()
+
+	err = producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("1")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("2")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		result := messaging.NewBatchResult(len(records))
+		for i, record := range records {
+			if string(record.Value) == "1" {
+				result[i] = errkit.WrapNonRetryable(assert.AnError)
+			}
+		}
+		return result
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		messaging.ConsumeEventBatch(ctx, cfg, routingProducer, "test.group", []topic.Topic{_topic}, handler)
+	})
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	admin := kadm.NewClient(producer)
+	committed := func() int64 {
+		offsets, err := admin.FetchOffsets(context.Background(), "test.group")
+		if err != nil {
+			return -1
+		}
+		offset, ok := offsets.Lookup(_topic.Primary, 0)
+		if !ok {
+			return -1
+		}
+		return offset.At
+	}
+
+	// nothing is committed while the failed record is not routed
+	time.Sleep(2 * time.Second)
+	require.Equal(t, int64(-1), committed())
+
+	_, err = admin.CreateTopic(context.Background(), 1, 1, nil, _topic.DLQ())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return committed() == 2 }, 30*time.Second, 50*time.Millisecond)
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+	require.Equal(t, "1", string(records[0].Value))
+}
+
+func TestConsumeEventBatch_ShutdownCommitsOnlyUpToFailedRecordNotRouted(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.batch-shutdown"}
+	// the DLQ topic is missing, so routing to it never succeeds
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1)})
+	cfg.Set(config.KafkaConsumerRouteBackoffSeconds, 1)
+
+	routingProducer, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.GetKafkaBootstrapServers()),
+		kgo.UnknownTopicRetries(0),
+	)
+	require.NoError(t, err)
+	defer routingProducer.Close()
+
+	err = producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("1")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("2")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("3")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	handled := make(chan struct{})
+	handler := func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		result := messaging.NewBatchResult(len(records))
+		for i, record := range records {
+			if string(record.Value) == "2" {
+				result[i] = errkit.WrapNonRetryable(assert.AnError)
+				close(handled)
+			}
+		}
+		return result
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		messaging.ConsumeEventBatch(ctx, cfg, routingProducer, "test.group", []topic.Topic{_topic}, handler)
+	})
+
+	<-handled
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	offsets, err := kadm.NewClient(producer).FetchOffsets(context.Background(), "test.group")
+	require.NoError(t, err)
+	offset, ok := offsets.Lookup(_topic.Primary, 0)
+	require.True(t, ok)
+	require.Equal(t, int64(1), offset.At)
+}
+
+func TestConsumeEventRetry_HandlesLegacyRetryRecord(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.retry-legacy"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.Retry(2), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1, 2})
+
+	handled := make(chan string, 1)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		handled <- record.Topic
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		messaging.ConsumeEventRetry(ctx, cfg, producer, "test.group.retry", []topic.Topic{_topic}, handler)
+	})
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// left in <topic>.retry by a worker from before retry tiers, without a due time
+	err := producer.ProduceSync(context.Background(), &kgo.Record{
+		Topic:   _topic.Primary + ".retry",
+		Value:   []byte(`{}`),
+		Headers: []kgo.RecordHeader{{Key: header.RetryCount, Value: []byte("1")}},
+	}).FirstErr()
+	require.NoError(t, err)
+
+	select {
+	case got := <-handled:
+		require.Equal(t, _topic.Primary+".retry", got)
+	case <-time.After(30 * time.Second):
+		t.Fatal("legacy retry record was not handled")
+	}
+}
@@ -0,0 +1,171 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func handlerTimeout(cfg *config.Config) time.Duration {
+	return time.Duration(cfg.GetKafkaConsumerHandlerTimeoutSeconds()) * time.Second
+}
+
+func handlerGrace(cfg *config.Config) time.Duration {
+	return time.Duration(cfg.GetKafkaConsumerHandlerGraceSeconds()) * time.Second
+}
+
+func panicError(ctx context.Context, r any) error {
+	logkit.Logger.WithContext(ctx).WithField("stack", string(debug.Stack())).Error("handler panicked")
+	return errkit.WrapNonRetryable(fmt.Errorf("handler panicked: %v", r))
+}
+
+func failAll(records []*kgo.Record, err error) BatchResult {
+	result := NewBatchResult(len(records))
+	for i := range result {
+		result[i] = err
+	}
+	return result
+}
+
+// RecoverSingle turns a panic into a non-retryable error, so one bad message goes to the
+// DLQ instead of killing the consumer goroutine.
+func RecoverSingle() MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = panicError(ctx, r)
+				}
+			}()
+			return next(ctx, record)
+		}
+	}
+}
+
+// RecoverBatch is RecoverSingle for a batch. A panic fails every record of the batch.
+func RecoverBatch() MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) (result BatchResult) {
+			defer func() {
+				if r := recover(); r != nil {
+					result = failAll(records, panicError(ctx, r))
+				}
+			}()
+			return next(ctx, records)
+		}
+	}
+}
+
+// timeout runs fn under a deadline of d. fn runs in its own goroutine: once the deadline
+// passes its ctx is cancelled and it has grace more to return, so its records are retried
+// only after it stopped. When fn ignores ctx past the grace too, timeout abandons it, so a
+// hung call can not block the partition, and returns a non-retryable error: the idempotency
+// key of an abandoned handler stays claimed until it returns, so a retry would be skipped as
+// a duplicate while fn may still complete, and the records go to the DLQ instead. Abandoned
+// handlers of consumerGroup are counted by telemetry.AddAbandonedHandler until they return.
+// A panic in fn is not recovered here, put a Recover middleware inside the Timeout one.
+func timeout(ctx context.Context, consumerGroup string, d time.Duration, grace time.Duration, fn func(ctx context.Context)) error {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(ctx)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	select {
+	case <-done:
+		// fn stopped on the cancelled ctx, its own outcome stands
+		return nil
+	case <-time.After(grace):
+	}
+
+	metricCtx := context.WithoutCancel(ctx)
+	telemetry.AddAbandonedHandler(metricCtx, consumerGroup, 1)
+	go func() {
+		<-done
+		telemetry.AddAbandonedHandler(metricCtx, consumerGroup, -1)
+	}()
+
+	err := fmt.Errorf("handler did not finish within %s and %s grace, abandoned", d, grace)
+	return errkit.WrapNonRetryable(err)
+}
+
+// TimeoutSingle runs the handler under a deadline of d, see timeout.
+func TimeoutSingle(consumerGroup string, d time.Duration, grace time.Duration) MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) error {
+			// handled is only read once fn returned, an abandoned handler may still write it later
+			var handled error
+			err := timeout(ctx, consumerGroup, d, grace, func(ctx context.Context) {
+				handled = next(ctx, record)
+			})
+			if err != nil {
+				return err
+			}
+			return handled
+		}
+	}
+}
+
+// TimeoutBatch is TimeoutSingle for a batch. An abandoned handler fails every record of the
+// batch.
+func TimeoutBatch(consumerGroup string, d time.Duration, grace time.Duration) MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) BatchResult {
+			var handled BatchResult
+			err := timeout(ctx, consumerGroup, d, grace, func(ctx context.Context) {
+				handled = next(ctx, records)
+			})
+			if err != nil {
+				return failAll(records, err)
+			}
+			return handled
+		}
+	}
+}
+
+// Limiter bounds how many handlers run at the same time across every consumer sharing it,
+// see LimitSingle.
+type Limiter chan struct{}
+
+func NewLimiter(n int) Limiter {
+	return make(Limiter, n)
+}
+
+// LimitSingle waits for a slot of limiter before calling the handler. The handler of an
+// abandoned record frees its slot when Timeout gives up on it, put Limit outside Timeout.
+func LimitSingle(limiter Limiter) MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) error {
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+			return next(ctx, record)
+		}
+	}
+}
+
+// LimitBatch is LimitSingle for a batch, a batch taking a single slot.
+func LimitBatch(limiter Limiter) MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) BatchResult {
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+			return next(ctx, records)
+		}
+	}
+}
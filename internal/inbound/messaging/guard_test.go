@@ -0,0 +1,295 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func headersOf(record *kgo.Record) map[string]string {
+	headers := map[string]string{}
+	for _, h := range record.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	return headers
+}
+
+// startSingle runs handler as a single mode subscription of the registry, which guards it
+// with a timeout and panic recovery.
+func startSingle(ctx context.Context, cfg *config.Config, producer *kgo.Client, wg *sync.WaitGroup, _topic topic.Topic, handler messaging.ConsumerHandlerSingle) {
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+
+	registry := messaging.NewRegistry(nil)
+	registry.Add(messaging.Subscription{
+		Topic:         _topic,
+		ConsumerGroup: "test.group",
+		Mode:          messaging.ModeSingle,
+		Single:        handler,
+	})
+	registry.Start(ctx, cfg, producer, wg)
+}
+
+func TestRegistry_Start_PanicGoesToDLQAndConsumerKeepsRunning(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.single-panic"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	handled := make(chan string, 1)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		if string(record.Value) == "panic" {
+			panic("boom")
+		}
+		handled <- string(record.Value)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	startSingle(ctx, cfg, producer, wg, _topic, handler)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("panic")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("ok")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+	require.Equal(t, "panic", string(records[0].Value))
+	headers := headersOf(records[0])
+	require.Equal(t, header.ErrorClassNonRetryable, headers[header.ErrorClass])
+	require.Contains(t, headers[header.ErrorMessage], "handler panicked: boom")
+
+	require.Equal(t, "ok", <-handled)
+}
+
+func TestRegistry_Start_TimeoutGoesToRetry(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.single-timeout"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerHandlerTimeoutSeconds, 1)
+
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	startSingle(ctx, cfg, producer, wg, _topic, handler)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte("hang")}).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.Retry(1), 1)
+	headers := headersOf(records[0])
+	require.Equal(t, header.ErrorClassRetryable, headers[header.ErrorClass])
+	require.Contains(t, headers[header.ErrorMessage], context.DeadlineExceeded.Error())
+	require.Equal(t, "1", headers[header.RetryCount])
+}
+
+func TestRegistry_Start_AbandonedHandlerGoesToDLQ(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.single-abandoned"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerHandlerTimeoutSeconds, 1)
+	cfg.Set(config.KafkaConsumerHandlerGraceSeconds, 1)
+
+	// the handler ignores ctx, like a call stuck on a connection without a deadline
+	release := make(chan struct{})
+	defer close(release)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		<-release
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	startSingle(ctx, cfg, producer, wg, _topic, handler)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte("hang")}).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+	headers := headersOf(records[0])
+	require.Equal(t, header.ErrorClassNonRetryable, headers[header.ErrorClass])
+	require.Contains(t, headers[header.ErrorMessage], "handler did not finish within 1s and 1s grace, abandoned")
+}
+
+func TestTimeoutSingle_AbandonedHandlerKeepsIdempotencyKeyUntilItFails(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	handler := messaging.ChainSingle(
+		func(ctx context.Context, record *kgo.Record) error {
+			if calls.Add(1) == 1 {
+				<-release
+				return assert.AnError
+			}
+			return nil
+		},
+		messaging.TimeoutSingle("image.liked.notify-owner", 10*time.Millisecond, 10*time.Millisecond),
+		func(next messaging.ConsumerHandlerSingle) messaging.ConsumerHandlerSingle {
+			return func(ctx context.Context, record *kgo.Record) error {
+				err := next(ctx, record)
+				if errors.Is(err, assert.AnError) {
+					close(returned)
+				}
+				return err
+			}
+		},
+		messaging.IdempotencySingle(usecase, "image.liked.notify-owner"),
+	)
+
+	err := handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.True(t, errkit.IsNonRetryable(err))
+
+	// while the abandoned handler runs its key stays claimed, a retry is skipped
+	err = handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+	require.Empty(t, usecase.DeleteCalls())
+
+	// the abandoned handler fails, its key is released and the replay is processed
+	close(release)
+	<-returned
+	require.Len(t, usecase.DeleteCalls(), 1)
+
+	err = handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestTimeoutSingle_AbandonedHandlerSucceedingKeepsIdempotencyKey(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	handler := messaging.ChainSingle(
+		func(ctx context.Context, record *kgo.Record) error {
+			<-release
+			return nil
+		},
+		messaging.TimeoutSingle("image.liked.notify-owner", 10*time.Millisecond, 10*time.Millisecond),
+		func(next messaging.ConsumerHandlerSingle) messaging.ConsumerHandlerSingle {
+			return func(ctx context.Context, record *kgo.Record) error {
+				defer close(returned)
+				return next(ctx, record)
+			}
+		},
+		messaging.IdempotencySingle(usecase, "image.liked.notify-owner"),
+	)
+
+	err := handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.True(t, errkit.IsNonRetryable(err))
+
+	close(release)
+	<-returned
+	require.Empty(t, usecase.DeleteCalls())
+}
+
+func TestTimeoutSingle_HandlerStoppedWithinGraceReleasesIdempotencyKey(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	handler := messaging.ChainSingle(
+		func(ctx context.Context, record *kgo.Record) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		messaging.TimeoutSingle("image.liked.notify-owner", 10*time.Millisecond, time.Second),
+		messaging.IdempotencySingle(usecase, "image.liked.notify-owner"),
+	)
+
+	err := handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, errkit.IsNonRetryable(err))
+	require.Len(t, usecase.DeleteCalls(), 1)
+}
+
+func TestRegistry_Start_BatchPanicGoesToDLQForEveryRecord(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.batch-panic"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+
+	handler := func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		panic("boom")
+	}
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("1")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("2")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	registry := messaging.NewRegistry(nil)
+	registry.Add(messaging.Subscription{
+		Topic:         _topic,
+		ConsumerGroup: "test.group",
+		Mode:          messaging.ModeBatch,
+		Batch:         handler,
+		Single:        func(ctx context.Context, record *kgo.Record) error { return nil },
+	})
+	registry.Start(ctx, cfg, producer, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 2)
+	for _, record := range records {
+		require.Equal(t, header.ErrorClassNonRetryable, headersOf(record)[header.ErrorClass])
+	}
+}
+
+func TestRegistry_Start_RetryPanicGoesToDLQ(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.retry-panic"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		panic("boom")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	startSingle(ctx, cfg, producer, wg, _topic, handler)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{
+		Topic:   _topic.Retry(1),
+		Value:   []byte("panic"),
+		Headers: []kgo.RecordHeader{{Key: header.RetryCount, Value: []byte("1")}},
+	}).FirstErr()
+	require.NoError(t, err)
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+	headers := headersOf(records[0])
+	require.Equal(t, header.ErrorClassNonRetryable, headers[header.ErrorClass])
+	require.Equal(t, "test.group.retry", headers[header.ErrorConsumerGroup])
+}
@@ -0,0 +1,56 @@
+package messaging
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func headerValue(record *kgo.Record, key string) (string, bool) {
+	for _, h := range record.Headers {
+		if h.Key == key {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
+
+// setHeader replaces the value of key, or appends it when the record does not have it yet.
+func setHeader(record *kgo.Record, key string, value string) {
+	for i, h := range record.Headers {
+		if h.Key == key {
+			record.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	record.Headers = append(record.Headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+}
+
+// setFailureHeaders records why and where record failed. It must run before the record is
+// moved to the retry or DLQ topic, because the original position is taken from the record
+// the first time it fails.
+func setFailureHeaders(record *kgo.Record, consumerGroup string, cause error) {
+	errorClass := header.ErrorClassRetryable
+	if errkit.IsNonRetryable(cause) {
+		errorClass = header.ErrorClassNonRetryable
+	}
+
+	errorMessage := ""
+	if cause != nil {
+		errorMessage = cause.Error()
+	}
+
+	setHeader(record, header.ErrorMessage, errorMessage)
+	setHeader(record, header.ErrorClass, errorClass)
+	setHeader(record, header.ErrorConsumerGroup, consumerGroup)
+
+	if _, ok := headerValue(record, header.OriginalTopic); !ok {
+		setHeader(record, header.OriginalTopic, record.Topic)
+		setHeader(record, header.OriginalPartition, strconv.FormatInt(int64(record.Partition), 10))
+		setHeader(record, header.OriginalOffset, strconv.FormatInt(record.Offset, 10))
+		setHeader(record, header.FirstFailureAt, time.Now().UTC().Format(time.RFC3339Nano))
+	}
+}
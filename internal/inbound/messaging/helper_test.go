@@ -0,0 +1,66 @@
+package messaging_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// newFakeKafka starts an in-memory kafka cluster with the given single partition topics and
+// returns a config pointing at it together with a producer client.
+func newFakeKafka(t *testing.T, topics []string) (*config.Config, *kgo.Client) {
+	t.Helper()
+	return newFakeKafkaPartitions(t, 1, topics)
+}
+
+// newFakeKafkaPartitions is newFakeKafka with the given number of partitions per topic. The
+// producer honours kgo.Record.Partition.
+func newFakeKafkaPartitions(t *testing.T, partitions int32, topics []string) (*config.Config, *kgo.Client) {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, topics...))
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+
+	cfg := config.NewConfig()
+	cfg.Set(config.KafkaBootstrapServers, strings.Join(cluster.ListenAddrs(), ","))
+
+	producer, err := kgo.NewClient(
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.RecordPartitioner(kgo.ManualPartitioner()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(producer.Close)
+
+	return cfg, producer
+}
+
+// pollRecords reads n records from the start of topicName, failing the test when they do
+// not arrive in time.
+func pollRecords(t *testing.T, cfg *config.Config, topicName string, n int) []*kgo.Record {
+	t.Helper()
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.GetKafkaBootstrapServers()),
+		kgo.ConsumeTopics(topicName),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	records := []*kgo.Record{}
+	for len(records) < n {
+		fetches := client.PollRecords(ctx, n-len(records))
+		require.Empty(t, fetches.Errors())
+		records = append(records, fetches.Records()...)
+	}
+	return records
+}
@@ -4,21 +4,28 @@ import (
 	"context"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/idempotencyusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/sirupsen/logrus"
 	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func idempotencyKey(record *kgo.Record) string {
-	for _, h := range record.Headers {
-		if h.Key == "x-idempotency-key" {
-			return string(h.Value)
-		}
+	key, _ := headerValue(record, header.IdempotencyKey)
+	return key
+}
+
+// IdempotencySingle skips records whose x-idempotency-key was already processed by
+// consumerGroup. Keys are scoped to the group, since every group subscribed to a topic must
+// handle the same record once. Put a Recover middleware inside it, so a panicking handler
+// still releases the key.
+func IdempotencySingle(usecase idempotencyusecase.IdempotencyUsecase, consumerGroup string) MiddlewareSingle {
+	return func(handler ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return idempotencySingle(usecase, consumerGroup, handler)
 	}
-	return ""
 }
 
-func IdempotencyHandlerSingle(usecase idempotencyusecase.IdempotencyUsecase, handler ConsumerHandlerSingle) ConsumerHandlerSingle {
+func idempotencySingle(usecase idempotencyusecase.IdempotencyUsecase, consumerGroup string, handler ConsumerHandlerSingle) ConsumerHandlerSingle {
 	return func(ctx context.Context, record *kgo.Record) error {
 		key := idempotencyKey(record)
 		if key == "" {
@@ -26,7 +33,7 @@ func IdempotencyHandlerSingle(usecase idempotencyusecase.IdempotencyUsecase, han
 			return handler(ctx, record)
 		}
 
-		isNew, err := usecase.InsertIfNotExists(ctx, key, record.Topic, record.Partition, record.Offset)
+		isNew, err := usecase.InsertIfNotExists(ctx, consumerGroup, key, record.Topic, record.Partition, record.Offset)
 		if err != nil {
 			logkit.Logger.WithContext(ctx).WithError(err).Error("idempotency check failed, processing without idempotency")
 			return handler(ctx, record)
@@ -34,6 +41,7 @@ func IdempotencyHandlerSingle(usecase idempotencyusecase.IdempotencyUsecase, han
 
 		if !isNew {
 			logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
+				"consumer_group":  consumerGroup,
 				"idempotency_key": key,
 				"topic":           record.Topic,
 				"partition":       record.Partition,
@@ -42,30 +50,68 @@ func IdempotencyHandlerSingle(usecase idempotencyusecase.IdempotencyUsecase, han
 			return nil
 		}
 
-		return handler(ctx, record)
+		err = handler(ctx, record)
+		if err != nil {
+			releaseIdempotencyKey(ctx, usecase, consumerGroup, key)
+			return err
+		}
+
+		return nil
 	}
 }
 
-func IdempotencyHandlerBatch(usecase idempotencyusecase.IdempotencyUsecase, handler ConsumerHandlerBatch) ConsumerHandlerBatch {
-	return func(ctx context.Context, records []*kgo.Record) error {
+// releaseIdempotencyKey deletes a key claimed for a record whose handler failed, so the
+// retry of that record (which carries the same key) is processed instead of skipped. A
+// handler abandoned on timeout keeps its key claimed until it returns, then releases it as
+// well if it failed, so replaying its record from the DLQ processes it.
+func releaseIdempotencyKey(ctx context.Context, usecase idempotencyusecase.IdempotencyUsecase, consumerGroup, key string) {
+	// the handler may have failed because its ctx was cancelled on timeout, the key must
+	// still be released
+	err := usecase.Delete(context.WithoutCancel(ctx), consumerGroup, key)
+	if err != nil {
+		logkit.Logger.WithContext(ctx).WithError(err).
+			WithFields(logrus.Fields{"consumer_group": consumerGroup, "idempotency_key": key}).
+			Error("failed to release idempotency key, retry of this message will be skipped")
+	}
+}
+
+// IdempotencyBatch is IdempotencySingle for a batch, duplicates are left out of the batch
+// passed to the handler and reported as succeeded.
+func IdempotencyBatch(usecase idempotencyusecase.IdempotencyUsecase, consumerGroup string) MiddlewareBatch {
+	return func(handler ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return idempotencyBatch(usecase, consumerGroup, handler)
+	}
+}
+
+func idempotencyBatch(usecase idempotencyusecase.IdempotencyUsecase, consumerGroup string, handler ConsumerHandlerBatch) ConsumerHandlerBatch {
+	return func(ctx context.Context, records []*kgo.Record) BatchResult {
+		result := NewBatchResult(len(records))
+
 		filtered := make([]*kgo.Record, 0, len(records))
-		for _, record := range records {
+		filteredIndexes := make([]int, 0, len(records))
+		filteredKeys := make([]string, 0, len(records))
+		for i, record := range records {
 			key := idempotencyKey(record)
 			if key == "" {
 				logkit.Logger.WithContext(ctx).Warn("record missing x-idempotency-key, including in batch without idempotency check")
 				filtered = append(filtered, record)
+				filteredIndexes = append(filteredIndexes, i)
+				filteredKeys = append(filteredKeys, "")
 				continue
 			}
 
-			isNew, err := usecase.InsertIfNotExists(ctx, key, record.Topic, record.Partition, record.Offset)
+			isNew, err := usecase.InsertIfNotExists(ctx, consumerGroup, key, record.Topic, record.Partition, record.Offset)
 			if err != nil {
 				logkit.Logger.WithContext(ctx).WithError(err).Error("idempotency check failed, including record without idempotency")
 				filtered = append(filtered, record)
+				filteredIndexes = append(filteredIndexes, i)
+				filteredKeys = append(filteredKeys, "")
 				continue
 			}
 
 			if !isNew {
 				logkit.Logger.WithContext(ctx).WithFields(logrus.Fields{
+					"consumer_group":  consumerGroup,
 					"idempotency_key": key,
 					"topic":           record.Topic,
 					"partition":       record.Partition,
@@ -75,12 +121,25 @@ func IdempotencyHandlerBatch(usecase idempotencyusecase.IdempotencyUsecase, hand
 			}
 
 			filtered = append(filtered, record)
+			filteredIndexes = append(filteredIndexes, i)
+			filteredKeys = append(filteredKeys, key)
 		}
 
 		if len(filtered) == 0 {
-			return nil
+			return result
+		}
+
+		filteredResult := handler(ctx, filtered)
+		for j, err := range filteredResult {
+			if err == nil {
+				continue
+			}
+			if filteredKeys[j] != "" {
+				releaseIdempotencyKey(ctx, usecase, consumerGroup, filteredKeys[j])
+			}
+			result[filteredIndexes[j]] = err
 		}
 
-		return handler(ctx, filtered)
+		return result
 	}
 }
@@ -0,0 +1,146 @@
+package messaging_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// newFakeIdempotencyUsecase returns an idempotency usecase backed by an in-memory key set.
+func newFakeIdempotencyUsecase() *mock.IdempotencyUsecaseMock {
+	// an abandoned handler may still use the keys from its own goroutine
+	var mu sync.Mutex
+	keys := map[[2]string]bool{}
+	return &mock.IdempotencyUsecaseMock{
+		InsertIfNotExistsFunc: func(ctx context.Context, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if keys[[2]string{consumerGroup, key}] {
+				return false, nil
+			}
+			keys[[2]string{consumerGroup, key}] = true
+			return true, nil
+		},
+		DeleteFunc: func(ctx context.Context, consumerGroup string, key string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			delete(keys, [2]string{consumerGroup, key})
+			return nil
+		},
+	}
+}
+
+func newRecord(topic string, key string) *kgo.Record {
+	return &kgo.Record{
+		Topic:   topic,
+		Headers: []kgo.RecordHeader{{Key: "x-idempotency-key", Value: []byte(key)}},
+	}
+}
+
+func TestIdempotencySingle_RetryRunsAfterFailure(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	calls := 0
+	handler := messaging.IdempotencySingle(usecase, "image.liked.notify-owner")(func(ctx context.Context, record *kgo.Record) error {
+		calls++
+		if calls == 1 {
+			return assert.AnError
+		}
+		return nil
+	})
+
+	// first delivery fails
+	err := handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.ErrorIs(t, err, assert.AnError)
+	require.Len(t, usecase.DeleteCalls(), 1)
+
+	// retry topic delivers the same key, handler must run again
+	err = handler(context.Background(), newRecord("image.liked.retry", "key-1"))
+	require.Nil(t, err)
+	require.Equal(t, 2, calls)
+
+	// once succeeded, a duplicate is skipped
+	err = handler(context.Background(), newRecord("image.liked", "key-1"))
+	require.Nil(t, err)
+	require.Equal(t, 2, calls)
+	require.Len(t, usecase.DeleteCalls(), 1)
+}
+
+func TestIdempotencyBatch_RetryRunsAfterFailure(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	var handled [][]*kgo.Record
+	batchHandler := messaging.IdempotencyBatch(usecase, "image.liked.batch-count")(func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		handled = append(handled, records)
+		result := messaging.NewBatchResult(len(records))
+		if len(handled) == 1 {
+			// only the second record fails
+			result[1] = assert.AnError
+		}
+		return result
+	})
+
+	records := []*kgo.Record{newRecord("image.liked", "key-1"), newRecord("image.liked", "key-2")}
+	result := batchHandler(context.Background(), records)
+	require.Nil(t, result[0])
+	require.ErrorIs(t, result[1], assert.AnError)
+	require.Len(t, usecase.DeleteCalls(), 1)
+	require.Equal(t, "image.liked.batch-count", usecase.DeleteCalls()[0].ConsumerGroup)
+	require.Equal(t, "key-2", usecase.DeleteCalls()[0].Key)
+
+	// the failed record is routed to the retry topic with the same key
+	singleCalls := 0
+	singleHandler := messaging.IdempotencySingle(usecase, "image.liked.batch-count")(func(ctx context.Context, record *kgo.Record) error {
+		singleCalls++
+		return nil
+	})
+	err := singleHandler(context.Background(), records[1])
+	require.Nil(t, err)
+	require.Equal(t, 1, singleCalls)
+
+	// redelivering the batch is now fully deduplicated
+	result = batchHandler(context.Background(), records)
+	require.Nil(t, result.Err())
+	require.Len(t, handled, 1)
+}
+
+func TestIdempotencySingle_KeysAreScopedToConsumerGroup(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+
+	notifyCalls := 0
+	notifyHandler := messaging.IdempotencySingle(usecase, "image.liked.notify-owner")(func(ctx context.Context, record *kgo.Record) error {
+		notifyCalls++
+		return nil
+	})
+	countCalls := 0
+	countHandler := messaging.IdempotencySingle(usecase, "image.liked.batch-count")(func(ctx context.Context, record *kgo.Record) error {
+		countCalls++
+		if countCalls == 1 {
+			return assert.AnError
+		}
+		return nil
+	})
+
+	record := newRecord("image.liked", "key-1")
+
+	// both groups read the same record from one topic, each must handle it once
+	require.Nil(t, notifyHandler(context.Background(), record))
+	require.ErrorIs(t, countHandler(context.Background(), record), assert.AnError)
+	require.Equal(t, 1, notifyCalls)
+	require.Equal(t, 1, countCalls)
+
+	// the failure releases only the key of its own group
+	require.Len(t, usecase.DeleteCalls(), 1)
+	require.Equal(t, "image.liked.batch-count", usecase.DeleteCalls()[0].ConsumerGroup)
+
+	require.Nil(t, notifyHandler(context.Background(), record))
+	require.Nil(t, countHandler(context.Background(), record))
+	require.Equal(t, 1, notifyCalls)
+	require.Equal(t, 2, countCalls)
+}
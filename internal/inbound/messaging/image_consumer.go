@@ -2,15 +2,11 @@ package messaging
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
-	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 type ImageConsumer struct {
@@ -23,150 +19,153 @@ func NewImageConsumer(usecase imageusecase.ImageUsecase) *ImageConsumer {
 	}
 }
 
-func (c *ImageConsumer) NotifyFollowerOnUpload(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	event := dto.ImageUploadedEvent{}
-	err := json.Unmarshal(record.Value, &event)
-	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.(*ImageConsumer).NotifyFollowerOnUpload")
-	}
-
+func (c *ImageConsumer) NotifyFollowerOnUpload(ctx context.Context, event dto.ImageUploadedEvent) error {
 	req := dto.NotifyFollowerOnUploadRequest{}
 	converter.DtoImageUploadedEventToDtoNotifyFollowerOnUploadRequest(event, &req)
 
-	err = c.Usecase.NotifyFollowerOnUpload(ctx, req)
+	err := c.Usecase.NotifyFollowerOnUpload(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).NotifyFollowerOnUpload")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) SyncImageToElasticsearch(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
+func (c *ImageConsumer) SyncImageToElasticsearch(ctx context.Context, event dto.ImageUploadedEvent) error {
+	req := dto.SyncImageToElasticsearchRequest{}
+	converter.DtoImageUploadedEventToDtoSyncImageToElasticsearchRequest(event, &req)
 
-	event := dto.ImageUploadedEvent{}
-	err := json.Unmarshal(record.Value, &event)
+	err := c.Usecase.SyncImageToElasticsearch(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.(*ImageConsumer).SyncImageToElasticsearch")
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).SyncImageToElasticsearch")
 	}
 
+	return nil
+}
+
+func (c *ImageConsumer) SyncUpdatedImageToElasticsearch(ctx context.Context, event dto.ImageUpdatedEvent) error {
 	req := dto.SyncImageToElasticsearchRequest{}
-	converter.DtoImageUploadedEventToDtoSyncImageToElasticsearchRequest(event, &req)
+	converter.DtoImageUpdatedEventToDtoSyncImageToElasticsearchRequest(event, &req)
 
-	err = c.Usecase.SyncImageToElasticsearch(ctx, req)
+	err := c.Usecase.SyncImageToElasticsearch(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).SyncImageToElasticsearch")
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).SyncUpdatedImageToElasticsearch")
+	}
+
+	return nil
+}
+
+func (c *ImageConsumer) DeleteImageObject(ctx context.Context, event dto.ImageDeletedEvent) error {
+	req := dto.DeleteImageObjectRequest{}
+	converter.DtoImageDeletedEventToDtoDeleteImageObjectRequest(event, &req)
+
+	err := c.Usecase.DeleteImageObject(ctx, req)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).DeleteImageObject")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) NotifyUserImageLiked(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
+func (c *ImageConsumer) RemoveImageFromElasticsearch(ctx context.Context, event dto.ImageDeletedEvent) error {
+	req := dto.RemoveImageFromElasticsearchRequest{}
+	converter.DtoImageDeletedEventToDtoRemoveImageFromElasticsearchRequest(event, &req)
 
-	event := dto.ImageLikedEvent{}
-	err := json.Unmarshal(record.Value, &event)
+	err := c.Usecase.RemoveImageFromElasticsearch(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.(*ImageConsumer).NotifyUserImageLiked")
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).RemoveImageFromElasticsearch")
 	}
 
+	return nil
+}
+
+func (c *ImageConsumer) NotifyUserImageLiked(ctx context.Context, event dto.ImageLikedEvent) error {
 	req := dto.NotifyUserImageLikedRequest{}
 	converter.DtoImageLikedEventToDtoNotifyUserImageLikedRequest(event, &req)
 
-	err = c.Usecase.NotifyUserImageLiked(ctx, req)
+	err := c.Usecase.NotifyUserImageLiked(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).NotifyUserImageLiked")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) BatchUpdateImageLikeCount(originalCtx context.Context, records []*kgo.Record) error {
-	ctx, span := telemetry.StartConsumerBatch(originalCtx, records)
-	defer span.End()
+// BatchUpdateImageLikeCount applies the like count changes of likes and unlikes, netted per
+// image, so an image liked then unliked within the batch is left untouched.
+func (c *ImageConsumer) BatchUpdateImageLikeCount(ctx context.Context, changes dto.ImageIncreaseLikeCountList) BatchResult {
+	result := BatchResult(applyBatch(ctx, changes, c.applyImageLikeCount))
+	for i, err := range result {
+		if err != nil {
+			result[i] = errkit.AddFuncName(err, "messaging.(*ImageConsumer).BatchUpdateImageLikeCount")
+		}
+	}
+
+	return result
+}
 
+func (c *ImageConsumer) applyImageLikeCount(ctx context.Context, changes dto.ImageIncreaseLikeCountList) error {
 	req := dto.BatchUpdateImageLikeCountRequest{}
-	converter.KGoRecordListToDtoBatchUpdateImageLikeCountRequest(ctx, records, &req)
+	converter.DtoImageIncreaseLikeCountListToDtoBatchUpdateImageLikeCountRequest(changes, &req)
 
 	err := c.Usecase.BatchUpdateImageLikeCount(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).BatchUpdateImageLikeCount")
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).applyImageLikeCount")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) UpdateImageLikeCount(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	err := c.BatchUpdateImageLikeCount(ctx, []*kgo.Record{record})
+func (c *ImageConsumer) UpdateImageLikeCount(ctx context.Context, change dto.ImageIncreaseLikeCount) error {
+	err := c.applyImageLikeCount(ctx, dto.ImageIncreaseLikeCountList{change})
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).UpdateImageLikeCount")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) NotifyUserImageCommented(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	event := dto.ImageCommentedEvent{}
-	err := json.Unmarshal(record.Value, &event)
-	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.(*ImageConsumer).NotifyUserImageCommented")
-	}
-
+func (c *ImageConsumer) NotifyUserImageCommented(ctx context.Context, event dto.ImageCommentedEvent) error {
 	req := dto.NotifyUserImageCommentedRequest{}
 	converter.DtoImageCommentedEventToDtoNotifyUserImageCommentedRequest(event, &req)
 
-	err = c.Usecase.NotifyUserImageCommented(ctx, req)
+	err := c.Usecase.NotifyUserImageCommented(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).NotifyUserImageCommented")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) BatchUpdateImageCommentCount(ctx context.Context, records []*kgo.Record) error {
-	ctx, span := telemetry.StartConsumerBatch(ctx, records)
-	defer span.End()
+// BatchUpdateImageCommentCount applies the comment count changes of added and deleted
+// comments, netted per image, so an image commented then uncommented within the batch is
+// left untouched.
+func (c *ImageConsumer) BatchUpdateImageCommentCount(ctx context.Context, changes dto.ImageIncreaseCommentCountList) BatchResult {
+	result := BatchResult(applyBatch(ctx, changes, c.applyImageCommentCount))
+	for i, err := range result {
+		if err != nil {
+			result[i] = errkit.AddFuncName(err, "messaging.(*ImageConsumer).BatchUpdateImageCommentCount")
+		}
+	}
+
+	return result
+}
 
+func (c *ImageConsumer) applyImageCommentCount(ctx context.Context, changes dto.ImageIncreaseCommentCountList) error {
 	req := dto.BatchUpdateImageCommentCountRequest{}
-	converter.KGoRecordListToDtoBatchUpdateImageCommentCountRequest(ctx, records, &req)
+	converter.DtoImageIncreaseCommentCountListToDtoBatchUpdateImageCommentCountRequest(changes, &req)
 
 	err := c.Usecase.BatchUpdateImageCommentCount(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).BatchUpdateImageCommentCount")
+		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).applyImageCommentCount")
 	}
 
 	return nil
 }
 
-func (c *ImageConsumer) UpdateImageCommentCount(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	err := c.BatchUpdateImageCommentCount(ctx, []*kgo.Record{record})
+func (c *ImageConsumer) UpdateImageCommentCount(ctx context.Context, change dto.ImageIncreaseCommentCount) error {
+	err := c.applyImageCommentCount(ctx, dto.ImageIncreaseCommentCountList{change})
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*ImageConsumer).UpdateImageCommentCount")
 	}
 
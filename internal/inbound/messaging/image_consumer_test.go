@@ -0,0 +1,113 @@
+package messaging_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+var imageLikeCountDecoders = []messaging.Decoder[dto.ImageIncreaseLikeCount]{
+	messaging.DecodeAs(eventschema.ImageLiked, converter.DtoImageLikedEventToDtoImageIncreaseLikeCount),
+	messaging.DecodeAs(eventschema.ImageUnliked, converter.DtoImageUnlikedEventToDtoImageIncreaseLikeCount),
+}
+
+var imageCommentCountDecoders = []messaging.Decoder[dto.ImageIncreaseCommentCount]{
+	messaging.DecodeAs(eventschema.ImageCommented, converter.DtoImageCommentedEventToDtoImageIncreaseCommentCount),
+	messaging.DecodeAs(eventschema.ImageCommentDeleted, converter.DtoImageCommentDeletedEventToDtoImageIncreaseCommentCount),
+}
+
+func TestImageConsumer_BatchUpdateImageLikeCount_PerRecordResult(t *testing.T) {
+	const failingImageID = 2
+
+	applied := map[int64]int{}
+	Usecase := &mock.ImageUsecaseMock{
+		BatchUpdateImageLikeCountFunc: func(ctx context.Context, req dto.BatchUpdateImageLikeCountRequest) error {
+			for _, v := range req.ImageIncreaseLikeCountList {
+				if v.ImageID == failingImageID {
+					return assert.AnError
+				}
+			}
+			for _, v := range req.ImageIncreaseLikeCountList {
+				applied[v.ImageID] += v.Count
+			}
+			return nil
+		},
+	}
+	c := messaging.NewImageConsumer(Usecase)
+
+	records := []*kgo.Record{
+		{Value: []byte(`{"image_id":1,"user_id":10}`)},
+		{Value: []byte(`not json`)},
+		{Value: []byte(`{"image_id":2,"user_id":10}`)},
+		{Value: []byte(`{"image_id":1,"user_id":11}`)},
+	}
+
+	result := messaging.DecodeBatchOf(c.BatchUpdateImageLikeCount, imageLikeCountDecoders...)(context.Background(), records)
+
+	require.Len(t, result, len(records))
+	require.Nil(t, result[0])
+	require.True(t, errkit.IsNonRetryable(result[1]))
+	require.ErrorIs(t, result[2], assert.AnError)
+	require.False(t, errkit.IsNonRetryable(result[2]))
+	require.Nil(t, result[3])
+
+	// the failed batch is not half applied, so succeeded records are counted exactly once
+	require.Equal(t, map[int64]int{1: 2}, applied)
+}
+
+func TestImageConsumer_BatchUpdateImageLikeCount_NetsLikesAndUnlikes(t *testing.T) {
+	var got dto.BatchUpdateImageLikeCountRequest
+	Usecase := &mock.ImageUsecaseMock{
+		BatchUpdateImageLikeCountFunc: func(ctx context.Context, req dto.BatchUpdateImageLikeCountRequest) error {
+			got = req
+			return nil
+		},
+	}
+	c := messaging.NewImageConsumer(Usecase)
+
+	records := []*kgo.Record{
+		{Value: []byte(`{"specversion":"1.0","type":"image.liked","schemaversion":1,"data":{"image_id":1,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.unliked","schemaversion":1,"data":{"image_id":1,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.unliked","schemaversion":1,"data":{"image_id":2,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.unliked","schemaversion":1,"data":{"image_id":2,"user_id":11}}`)},
+	}
+
+	result := messaging.DecodeBatchOf(c.BatchUpdateImageLikeCount, imageLikeCountDecoders...)(context.Background(), records)
+
+	// image 1 was liked then unliked, so it is left out
+	require.NoError(t, result.Err())
+	require.Equal(t, dto.ImageIncreaseLikeCountList{{ImageID: 2, Count: -2}}, got.ImageIncreaseLikeCountList)
+}
+
+func TestImageConsumer_BatchUpdateImageCommentCount_NetsCommentsAndDeletedComments(t *testing.T) {
+	var got dto.BatchUpdateImageCommentCountRequest
+	Usecase := &mock.ImageUsecaseMock{
+		BatchUpdateImageCommentCountFunc: func(ctx context.Context, req dto.BatchUpdateImageCommentCountRequest) error {
+			got = req
+			return nil
+		},
+	}
+	c := messaging.NewImageConsumer(Usecase)
+
+	records := []*kgo.Record{
+		{Value: []byte(`{"specversion":"1.0","type":"image.commented","schemaversion":1,"data":{"id":1,"image_id":1,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.comment_deleted","schemaversion":1,"data":{"id":1,"image_id":1,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.comment_deleted","schemaversion":1,"data":{"id":2,"image_id":2,"user_id":10}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"image.comment_deleted","schemaversion":1,"data":{"id":3,"image_id":2,"user_id":11}}`)},
+	}
+
+	result := messaging.DecodeBatchOf(c.BatchUpdateImageCommentCount, imageCommentCountDecoders...)(context.Background(), records)
+
+	// image 1 was commented then its comment deleted, so it is left out
+	require.NoError(t, result.Err())
+	require.Equal(t, dto.ImageIncreaseCommentCountList{{ImageID: 2, Count: -2}}, got.ImageIncreaseCommentCountList)
+}
@@ -0,0 +1,274 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/sirupsen/logrus"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type MiddlewareSingle func(next ConsumerHandlerSingle) ConsumerHandlerSingle
+
+type MiddlewareBatch func(next ConsumerHandlerBatch) ConsumerHandlerBatch
+
+// ChainSingle wraps handler with mws, the first middleware being the outermost.
+func ChainSingle(handler ConsumerHandlerSingle, mws ...MiddlewareSingle) ConsumerHandlerSingle {
+	for i := len(mws) - 1; i >= 0; i-- {
+		handler = mws[i](handler)
+	}
+	return handler
+}
+
+// ChainBatch wraps handler with mws, the first middleware being the outermost.
+func ChainBatch(handler ConsumerHandlerBatch, mws ...MiddlewareBatch) ConsumerHandlerBatch {
+	for i := len(mws) - 1; i >= 0; i-- {
+		handler = mws[i](handler)
+	}
+	return handler
+}
+
+// EventHandlerSingle handles one decoded event, see DecodeSingle.
+type EventHandlerSingle[E any] func(ctx context.Context, event E) error
+
+// EventHandlerBatch handles decoded events, returning a result aligned with events, see
+// DecodeBatch.
+type EventHandlerBatch[S ~[]E, E any] func(ctx context.Context, events S) BatchResult
+
+// decode decodes record as an event of schema with the codec named by its content-type
+// header.
+func decode(schema eventkit.Schema, record *kgo.Record, dst any) error {
+	contentType, _ := headerValue(record, header.ContentType)
+	codec, err := eventkit.CodecFor(contentType)
+	if err != nil {
+		return err
+	}
+
+	_, err = schema.Unmarshal(codec, record.Value, dst)
+	return err
+}
+
+// Decoder decodes a record into E, failing on a record of another event type, see DecodeAs.
+type Decoder[E any] func(record *kgo.Record) (E, error)
+
+// DecodeAs returns a Decoder of records holding events of schema, converted into E by
+// convert. It lets a subscription consuming several topics hand their events to one handler,
+// see DecodeSingleOf.
+func DecodeAs[T, E any](schema eventkit.Schema, convert func(event T, dst *E)) Decoder[E] {
+	return func(record *kgo.Record) (E, error) {
+		var event T
+		var dst E
+		err := decode(schema, record, &event)
+		if err != nil {
+			return dst, err
+		}
+
+		convert(event, &dst)
+		return dst, nil
+	}
+}
+
+// decodeAs decodes record with the first of decoders accepting its event type. A legacy
+// record without envelope is accepted by the first decoder.
+func decodeAs[E any](decoders []Decoder[E], record *kgo.Record) (E, error) {
+	var firstErr error
+	for _, decoder := range decoders {
+		event, err := decoder(record)
+		if err == nil {
+			return event, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	var zero E
+	return zero, firstErr
+}
+
+// DecodeSingle turns a typed handler into a ConsumerHandlerSingle. Records are decoded as
+// events of schema, upcasting older versions, see eventkit.Schema.Unmarshal. A record that
+// can not be decoded into E fails with a non-retryable error, since retrying will not fix it.
+func DecodeSingle[E any](schema eventkit.Schema, handler EventHandlerSingle[E]) ConsumerHandlerSingle {
+	return DecodeSingleOf(handler, DecodeAs(schema, func(event E, dst *E) { *dst = event }))
+}
+
+// DecodeSingleOf is DecodeSingle for records holding events of any of decoders.
+func DecodeSingleOf[E any](handler EventHandlerSingle[E], decoders ...Decoder[E]) ConsumerHandlerSingle {
+	return func(ctx context.Context, record *kgo.Record) error {
+		event, err := decodeAs(decoders, record)
+		if err != nil {
+			return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.DecodeSingleOf")
+		}
+
+		return handler(ctx, event)
+	}
+}
+
+// DecodeBatch turns a typed batch handler into a ConsumerHandlerBatch, decoding records as
+// DecodeSingle does. Records that can not be decoded fail with a non-retryable error and
+// are left out of the events passed to handler.
+func DecodeBatch[S ~[]E, E any](schema eventkit.Schema, handler EventHandlerBatch[S, E]) ConsumerHandlerBatch {
+	return DecodeBatchOf(handler, DecodeAs(schema, func(event E, dst *E) { *dst = event }))
+}
+
+// DecodeBatchOf is DecodeBatch for records holding events of any of decoders.
+func DecodeBatchOf[S ~[]E, E any](handler EventHandlerBatch[S, E], decoders ...Decoder[E]) ConsumerHandlerBatch {
+	return func(ctx context.Context, records []*kgo.Record) BatchResult {
+		result := NewBatchResult(len(records))
+
+		events := make(S, 0, len(records))
+		eventIndexes := make([]int, 0, len(records))
+		for i, record := range records {
+			event, err := decodeAs(decoders, record)
+			if err != nil {
+				result[i] = errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.DecodeBatchOf")
+				continue
+			}
+			events = append(events, event)
+			eventIndexes = append(eventIndexes, i)
+		}
+
+		if len(events) == 0 {
+			return result
+		}
+
+		for j, err := range handler(ctx, events) {
+			result[eventIndexes[j]] = err
+		}
+
+		return result
+	}
+}
+
+// TracingSingle starts a consumer span named after the consumer group, as a child of the
+// producer span carried in the record.
+func TracingSingle(consumerGroup string) MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) error {
+			ctx, span := telemetry.StartConsumerNamed(ctx, consumerGroup, record)
+			defer span.End()
+
+			err := next(ctx, record)
+			telemetry.RecordError(span, err)
+			return err
+		}
+	}
+}
+
+// TracingBatch starts a batch span named after the consumer group, linked with the
+// producer span of every record, see telemetry.StartConsumerBatch.
+func TracingBatch(consumerGroup string) MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) BatchResult {
+			ctx, span := telemetry.StartConsumerBatchNamed(ctx, consumerGroup, records)
+			defer span.End()
+
+			result := next(ctx, records)
+			telemetry.RecordError(span, result.Err())
+			return result
+		}
+	}
+}
+
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errkit.IsNonRetryable(err):
+		return header.ErrorClassNonRetryable
+	default:
+		return header.ErrorClassRetryable
+	}
+}
+
+// MetricsSingle records the outcome and duration of every record, see telemetry.RecordConsumed.
+func MetricsSingle(consumerGroup string) MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) error {
+			start := time.Now()
+			err := next(ctx, record)
+			telemetry.RecordConsumed(ctx, consumerGroup, record.Topic, outcome(err), 1, time.Since(start))
+			return err
+		}
+	}
+}
+
+// MetricsBatch is MetricsSingle for a batch, the duration being the one of the whole batch.
+func MetricsBatch(consumerGroup string) MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) BatchResult {
+			start := time.Now()
+			result := next(ctx, records)
+			duration := time.Since(start)
+
+			if len(records) == 0 {
+				return result
+			}
+
+			counts := map[string]int{}
+			for _, err := range result {
+				counts[outcome(err)]++
+			}
+			for o, count := range counts {
+				telemetry.RecordConsumed(ctx, consumerGroup, records[0].Topic, o, count, duration)
+			}
+			return result
+		}
+	}
+}
+
+func recordFields(consumerGroup string, record *kgo.Record) logrus.Fields {
+	return logrus.Fields{
+		"consumerGroup": consumerGroup,
+		"topic":         record.Topic,
+		"partition":     record.Partition,
+		"offset":        record.Offset,
+	}
+}
+
+// LoggingSingle logs every failed record at error level and every processed one at debug.
+func LoggingSingle(consumerGroup string) MiddlewareSingle {
+	return func(next ConsumerHandlerSingle) ConsumerHandlerSingle {
+		return func(ctx context.Context, record *kgo.Record) error {
+			start := time.Now()
+			err := next(ctx, record)
+
+			entry := logkit.Logger.WithContext(ctx).WithFields(recordFields(consumerGroup, record)).
+				WithField("duration", time.Since(start).String())
+			if err != nil {
+				entry.WithError(err).Error("message failed")
+			} else {
+				entry.Debug("message processed")
+			}
+			return err
+		}
+	}
+}
+
+// LoggingBatch is LoggingSingle for a batch.
+func LoggingBatch(consumerGroup string) MiddlewareBatch {
+	return func(next ConsumerHandlerBatch) ConsumerHandlerBatch {
+		return func(ctx context.Context, records []*kgo.Record) BatchResult {
+			start := time.Now()
+			result := next(ctx, records)
+			duration := time.Since(start).String()
+
+			for i, err := range result {
+				entry := logkit.Logger.WithContext(ctx).WithFields(recordFields(consumerGroup, records[i])).
+					WithField("duration", duration)
+				if err != nil {
+					entry.WithError(err).Error("message failed in batch")
+				} else {
+					entry.Debug("message processed in batch")
+				}
+			}
+			return result
+		}
+	}
+}
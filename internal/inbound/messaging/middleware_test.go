@@ -0,0 +1,186 @@
+package messaging_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestChainSingle_FirstMiddlewareIsOutermost(t *testing.T) {
+	calls := []string{}
+	mw := func(name string) messaging.MiddlewareSingle {
+		return func(next messaging.ConsumerHandlerSingle) messaging.ConsumerHandlerSingle {
+			return func(ctx context.Context, record *kgo.Record) error {
+				calls = append(calls, name+" before")
+				err := next(ctx, record)
+				calls = append(calls, name+" after")
+				return err
+			}
+		}
+	}
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		calls = append(calls, "handler")
+		return nil
+	}
+
+	err := messaging.ChainSingle(handler, mw("a"), mw("b"))(context.Background(), &kgo.Record{})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"a before", "b before", "handler", "b after", "a after"}, calls)
+}
+
+func TestDecodeSingle_Success(t *testing.T) {
+	var got dto.ImageLikedEvent
+	handler := messaging.DecodeSingle(eventschema.ImageLiked, func(ctx context.Context, event dto.ImageLikedEvent) error {
+		got = event
+		return nil
+	})
+
+	err := handler(context.Background(), &kgo.Record{Value: []byte(`{"image_id":1,"user_id":10}`)})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.ImageID)
+	require.Equal(t, int64(10), got.UserID)
+}
+
+func TestDecodeSingle_Fail_BadJSONIsNonRetryable(t *testing.T) {
+	called := false
+	handler := messaging.DecodeSingle(eventschema.ImageLiked, func(ctx context.Context, event dto.ImageLikedEvent) error {
+		called = true
+		return nil
+	})
+
+	err := handler(context.Background(), &kgo.Record{Value: []byte(`not json`)})
+
+	require.True(t, errkit.IsNonRetryable(err))
+	require.False(t, called)
+}
+
+func TestDecodeSingleOf_Success_DecodesByEventType(t *testing.T) {
+	var got []string
+	handler := messaging.DecodeSingleOf(func(ctx context.Context, event string) error {
+		got = append(got, event)
+		return nil
+	},
+		messaging.DecodeAs(eventschema.ImageLiked, func(event dto.ImageLikedEvent, dst *string) { *dst = "liked" }),
+		messaging.DecodeAs(eventschema.ImageUnliked, func(event dto.ImageUnlikedEvent, dst *string) { *dst = "unliked" }),
+	)
+
+	for _, value := range []string{
+		`{"specversion":"1.0","type":"image.unliked","schemaversion":1,"data":{"image_id":1,"user_id":10}}`,
+		`{"specversion":"1.0","type":"image.liked","schemaversion":1,"data":{"image_id":1,"user_id":10}}`,
+		// legacy payload without envelope, taken by the first decoder
+		`{"image_id":1,"user_id":10}`,
+	} {
+		err := handler(context.Background(), &kgo.Record{Value: []byte(value)})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"unliked", "liked", "liked"}, got)
+}
+
+func TestDecodeSingleOf_Fail_UnknownEventTypeIsNonRetryable(t *testing.T) {
+	handler := messaging.DecodeSingleOf(func(ctx context.Context, event string) error {
+		return nil
+	},
+		messaging.DecodeAs(eventschema.ImageLiked, func(event dto.ImageLikedEvent, dst *string) { *dst = "liked" }),
+	)
+
+	err := handler(context.Background(), &kgo.Record{Value: []byte(`{"specversion":"1.0","type":"image.unliked","schemaversion":1,"data":{}}`)})
+
+	require.True(t, errkit.IsNonRetryable(err))
+	require.ErrorContains(t, err, "expected event type image.liked, got image.unliked")
+}
+
+func TestDecodeSingle_Success_ProtobufByContentTypeHeader(t *testing.T) {
+	value, err := eventschema.ImageLiked.Marshal(eventkit.Protobuf, "id-1", "test", "1", time.Now(), dto.ImageLikedEvent{ImageID: 1, UserID: 10})
+	require.NoError(t, err)
+
+	var got dto.ImageLikedEvent
+	handler := messaging.DecodeSingle(eventschema.ImageLiked, func(ctx context.Context, event dto.ImageLikedEvent) error {
+		got = event
+		return nil
+	})
+
+	err = handler(context.Background(), &kgo.Record{
+		Value:   value,
+		Headers: []kgo.RecordHeader{{Key: header.ContentType, Value: []byte(eventkit.ContentTypeCloudEventsProtobuf)}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.ImageID)
+	require.Equal(t, int64(10), got.UserID)
+}
+
+func TestDecodeSingle_Fail_UnknownContentTypeIsNonRetryable(t *testing.T) {
+	handler := messaging.DecodeSingle(eventschema.ImageLiked, func(ctx context.Context, event dto.ImageLikedEvent) error {
+		return nil
+	})
+
+	err := handler(context.Background(), &kgo.Record{
+		Value:   []byte(`{"image_id":1,"user_id":10}`),
+		Headers: []kgo.RecordHeader{{Key: header.ContentType, Value: []byte("application/avro")}},
+	})
+
+	require.True(t, errkit.IsNonRetryable(err))
+}
+
+func TestRecoverSingle_InsideIdempotencyReleasesKey(t *testing.T) {
+	usecase := newFakeIdempotencyUsecase()
+	panics := true
+	handler := messaging.ChainSingle(
+		func(ctx context.Context, record *kgo.Record) error {
+			if panics {
+				panic("boom")
+			}
+			return nil
+		},
+		messaging.IdempotencySingle(usecase, "image.liked.notify-owner"),
+		messaging.RecoverSingle(),
+	)
+	record := newRecord("image.liked", "key-1")
+
+	err := handler(context.Background(), record)
+	require.True(t, errkit.IsNonRetryable(err))
+	require.Len(t, usecase.DeleteCalls(), 1)
+
+	panics = false
+	err = handler(context.Background(), record)
+	require.NoError(t, err)
+}
+
+func TestRecoverBatch_PanicFailsEveryRecord(t *testing.T) {
+	handler := messaging.RecoverBatch()(func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+		panic("boom")
+	})
+
+	result := handler(context.Background(), []*kgo.Record{{}, {}})
+
+	require.Len(t, result, 2)
+	for _, err := range result {
+		require.True(t, errkit.IsNonRetryable(err))
+	}
+}
+
+func TestChainSingle_ObservabilityMiddlewarePassErrorThrough(t *testing.T) {
+	handler := messaging.ChainSingle(
+		func(ctx context.Context, record *kgo.Record) error { return assert.AnError },
+		messaging.TracingSingle("test.group"),
+		messaging.MetricsSingle("test.group"),
+		messaging.LoggingSingle("test.group"),
+	)
+
+	err := handler(context.Background(), &kgo.Record{Topic: "image.liked"})
+
+	require.ErrorIs(t, err, assert.AnError)
+}
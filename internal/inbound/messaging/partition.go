@@ -0,0 +1,137 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// partitionRunner processes the records of every partition in a goroutine of its own, so a
+// slow partition never holds back the others nor the poll loop. A partition is paused from
+// the poll that returned its records until they are processed, then resumed, so it has at
+// most one batch in flight and its records are still processed in offset order.
+//
+// The client must be created with kgo.BlockRebalanceOnPoll and call revoked when partitions
+// are revoked or lost, so a partition is never given away while its records are in flight.
+type partitionRunner struct {
+	mu       sync.Mutex
+	inFlight map[topicPartition]partitionRun
+	stopped  map[topicPartition]bool
+	wg       sync.WaitGroup
+}
+
+type partitionRun struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newPartitionRunner() *partitionRunner {
+	return &partitionRunner{
+		inFlight: map[topicPartition]partitionRun{},
+		stopped:  map[topicPartition]bool{},
+	}
+}
+
+// dispatch starts process for the records of every partition in fetches. process gets a ctx
+// of its own partition, cancelled once ctx is done or the partition is revoked, and returns
+// false when that ctx was done before it could route a failed record. That partition then
+// stays paused until revoked, so no later record of it is committed past the failed one,
+// which is consumed again by the next owner of the partition. Call client.AllowRebalance
+// once dispatch returns.
+func (r *partitionRunner) dispatch(ctx context.Context, client *kgo.Client, fetches kgo.Fetches, process func(ctx context.Context, records []*kgo.Record) bool) {
+	fetches.EachPartition(func(p kgo.FetchTopicPartition) {
+		if len(p.Records) == 0 {
+			return
+		}
+
+		partitions := map[string][]int32{p.Topic: {p.Partition}}
+		client.PauseFetchPartitions(partitions)
+
+		tp := topicPartition{topic: p.Topic, partition: p.Partition}
+		partitionCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		r.mu.Lock()
+		r.inFlight[tp] = partitionRun{cancel: cancel, done: done}
+		r.mu.Unlock()
+
+		r.wg.Go(func() {
+			defer cancel()
+
+			ok := process(partitionCtx, p.Records)
+
+			r.mu.Lock()
+			delete(r.inFlight, tp)
+			if !ok {
+				r.stopped[tp] = true
+			}
+			close(done)
+			r.mu.Unlock()
+
+			if ok {
+				client.ResumeFetchPartitions(partitions)
+			}
+		})
+	})
+}
+
+// revoked cancels the ctx of the in-flight records of partitions and waits until they are
+// processed and committed, a record still waiting its turn is left uncommitted. Stopped
+// partitions are resumed, so they are fetched again from their committed offset if they come
+// back. It is the OnPartitionsRevoked and OnPartitionsLost callback of the client.
+func (r *partitionRunner) revoked(ctx context.Context, client *kgo.Client, partitions map[string][]int32) {
+	dones := []chan struct{}{}
+	r.mu.Lock()
+	for t, ps := range partitions {
+		for _, p := range ps {
+			if run, ok := r.inFlight[topicPartition{topic: t, partition: p}]; ok {
+				run.cancel()
+				dones = append(dones, run.done)
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	for _, done := range dones {
+		<-done
+	}
+
+	resume := map[string][]int32{}
+	r.mu.Lock()
+	for t, ps := range partitions {
+		for _, p := range ps {
+			tp := topicPartition{topic: t, partition: p}
+			if r.stopped[tp] {
+				delete(r.stopped, tp)
+				resume[t] = append(resume[t], p)
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	if len(resume) > 0 {
+		client.ResumeFetchPartitions(resume)
+	}
+}
+
+// wait waits until every partition in flight is processed.
+func (r *partitionRunner) wait() {
+	r.wg.Wait()
+}
+
+// commitRecords commits the offset after the last of records in each of their partitions,
+// and only those partitions, so a partition that is done is committed without waiting for
+// the others. The commit outlives ctx, so work finished during shutdown is not processed
+// again.
+func commitRecords(ctx context.Context, client *kgo.Client, localLogger *logrus.Entry, records ...*kgo.Record) {
+	err := client.CommitRecords(context.WithoutCancel(ctx), records...)
+	if err != nil {
+		localLogger.WithError(err).Error("client error commit")
+	}
+}
@@ -0,0 +1,281 @@
+package messaging_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestConsumeEventSingle_ProcessesPartitionsConcurrentlyInOrder(t *testing.T) {
+	const partitions = 4
+	const recordsPerPartition = 5
+
+	_topic := topic.Topic{Primary: "test.partitions"}
+	cfg, producer := newFakeKafkaPartitions(t, partitions, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	records := []*kgo.Record{}
+	for i := range recordsPerPartition {
+		for p := range int32(partitions) {
+			records = append(records, &kgo.Record{Topic: _topic.Primary, Partition: p, Value: []byte(strconv.Itoa(i))})
+		}
+	}
+	err := producer.ProduceSync(context.Background(), records...).FirstErr()
+	require.NoError(t, err)
+
+	mu := sync.Mutex{}
+	inFlight, maxInFlight, total := 0, 0, 0
+	seen := map[int32][]string{}
+	done := make(chan struct{})
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		inFlight--
+		seen[record.Partition] = append(seen[record.Partition], string(record.Value))
+		total++
+		if total == partitions*recordsPerPartition {
+			close(done)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("records were not all processed")
+	}
+
+	mu.Lock()
+	require.Greater(t, maxInFlight, 1)
+	for p := range int32(partitions) {
+		require.Equal(t, []string{"0", "1", "2", "3", "4"}, seen[p], "partition %d", p)
+	}
+	mu.Unlock()
+
+	// every partition is committed past its last record
+	admin := kadm.NewClient(producer)
+	require.Eventually(t, func() bool {
+		offsets, err := admin.FetchOffsets(context.Background(), "test.group")
+		if err != nil {
+			return false
+		}
+		for p := range int32(partitions) {
+			o, ok := offsets.Lookup(_topic.Primary, p)
+			if !ok || o.At != recordsPerPartition {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestConsumeEventSingle_SlowPartitionDoesNotHoldBackOthers(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.slow-partition"}
+	cfg, producer := newFakeKafkaPartitions(t, 2, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+
+	release := make(chan struct{})
+	handled := make(chan string, 10)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		if record.Partition == 0 {
+			<-release
+		}
+		handled <- string(record.Value)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Partition: 0, Value: []byte("slow")},
+		&kgo.Record{Topic: _topic.Primary, Partition: 1, Value: []byte("fast-1")},
+	).FirstErr()
+	require.NoError(t, err)
+	require.Equal(t, "fast-1", <-handled)
+
+	// polled after partition 0 got stuck, and still handled
+	err = producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Partition: 1, Value: []byte("fast-2")}).FirstErr()
+	require.NoError(t, err)
+	require.Equal(t, "fast-2", <-handled)
+
+	close(release)
+	require.Equal(t, "slow", <-handled)
+}
+
+func TestConsumeEventSingle_HandlerFailedByShutdownIsRoutedBeforeCommit(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.shutdown"}
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte("1")}).FirstErr()
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() { messaging.ConsumeEventSingle(ctx, cfg, producer, "test.group", []topic.Topic{_topic}, handler) })
+
+	<-started
+	cancel()
+	wg.Wait()
+
+	// the record is in the retry topic, so committing it past the failure lost nothing
+	records := pollRecords(t, cfg, _topic.Retry(1), 1)
+	require.Equal(t, "1", string(records[0].Value))
+
+	offsets, err := kadm.NewClient(producer).FetchOffsets(context.Background(), "test.group")
+	require.NoError(t, err)
+	offset, ok := offsets.Lookup(_topic.Primary, 0)
+	require.True(t, ok)
+	require.Equal(t, int64(1), offset.At)
+}
+
+func TestConsumeEventRetry_RevokeDoesNotWaitForRetryToBeDue(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.revoke"}
+	cfg, producer := newFakeKafkaPartitions(t, 2, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{600})
+
+	dueAt := strconv.FormatInt(time.Now().Add(10*time.Minute).UnixMilli(), 10)
+	for p := range int32(2) {
+		err := producer.ProduceSync(context.Background(), &kgo.Record{
+			Topic:     _topic.Retry(1),
+			Partition: p,
+			Value:     []byte(strconv.Itoa(int(p))),
+			Headers:   []kgo.RecordHeader{{Key: header.RetryCount, Value: []byte("1")}, {Key: header.RetryDueAt, Value: []byte(dueAt)}},
+		}).FirstErr()
+		require.NoError(t, err)
+	}
+
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		t.Error("handler called before the retry was due")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		messaging.ConsumeEventRetry(ctx, cfg, producer, "test.group.retry", []topic.Topic{_topic}, handler)
+	})
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// give the consumer time to take both partitions and wait on their records
+	time.Sleep(2 * time.Second)
+
+	// a second member gets a partition only once the first one revoked it, and reads the
+	// record left uncommitted there
+	member, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.GetKafkaBootstrapServers()),
+		kgo.ConsumerGroup("test.group.retry"),
+		kgo.ConsumeTopics(_topic.Retry(1)),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	require.NoError(t, err)
+	defer member.Close()
+
+	pollCtx, pollCancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer pollCancel()
+	fetches := member.PollRecords(pollCtx, 1)
+	require.Empty(t, fetches.Errors())
+	require.Len(t, fetches.Records(), 1)
+}
+
+func TestConsumeEventSingle_FailedRecordNotRoutedIsRoutedAgainBeforeTheRest(t *testing.T) {
+	_topic := topic.Topic{Primary: "test.route-again"}
+	// the DLQ topic is missing, so routing to it fails until it is created
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1)})
+	cfg.Set(config.KafkaConsumerRouteBackoffSeconds, 1)
+
+	routingProducer, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.GetKafkaBootstrapServers()),
+		kgo.UnknownTopicRetries(0),
+	)
+	require.NoError(t, err)
+	defer routingProducer.Close()
+
+	err = producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("1")},
+		&kgo.Record{Topic: _topic.Primary, Value: []byte("2")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	handled := make(chan string, 2)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		handled <- string(record.Value)
+		if string(record.Value) == "1" {
+			return errkit.WrapNonRetryable(assert.AnError)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		messaging.ConsumeEventSingle(ctx, cfg, routingProducer, "test.group", []topic.Topic{_topic}, handler)
+	})
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	require.Equal(t, "1", <-handled)
+
+	// the next record waits while the failed one is not routed
+	select {
+	case value := <-handled:
+		t.Fatalf("record %s handled before the failed record was routed", value)
+	case <-time.After(2 * time.Second):
+	}
+
+	_, err = kadm.NewClient(producer).CreateTopic(context.Background(), 1, 1, nil, _topic.DLQ())
+	require.NoError(t, err)
+
+	select {
+	case value := <-handled:
+		require.Equal(t, "2", value)
+	case <-time.After(30 * time.Second):
+		t.Fatal("next record not handled once the failed record could be routed")
+	}
+
+	records := pollRecords(t, cfg, _topic.DLQ(), 1)
+	require.Equal(t, "1", string(records[0].Value))
+}
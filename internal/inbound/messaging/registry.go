@@ -0,0 +1,209 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/idempotencyusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type Mode string
+
+const (
+	ModeSingle Mode = "single"
+	ModeBatch  Mode = "batch"
+)
+
+// Subscription declares one consumer group on one topic. The registry derives its retry
+// consumer from it, and a DLQ consumer for every subscribed topic.
+type Subscription struct {
+	Topic         topic.Topic
+	ConsumerGroup string
+	Mode          Mode
+	Idempotent    bool
+
+	// ExtraTopics are consumed by the same group as Topic, so events that cancel each other
+	// out, e.g. likes and unlikes, are handled together, see DecodeBatchOf.
+	ExtraTopics []topic.Topic
+
+	// Single handles one record. It consumes the primary topic in ModeSingle, and the retry
+	// topics in both modes, since retried records come back one at a time.
+	Single ConsumerHandlerSingle
+
+	// Batch consumes the primary topic in ModeBatch.
+	Batch ConsumerHandlerBatch
+}
+
+// Topics returns Topic followed by ExtraTopics.
+func (s Subscription) Topics() []topic.Topic {
+	return append([]topic.Topic{s.Topic}, s.ExtraTopics...)
+}
+
+func (s Subscription) RetryConsumerGroup() string {
+	return s.ConsumerGroup + ".retry"
+}
+
+// DLQConsumerGroup is the group logging the dead letters of every subscribed topic, see
+// ConsumeEventDLQ.
+const DLQConsumerGroup = "dlq.log"
+
+type Registry struct {
+	Subscriptions      []Subscription
+	IdempotencyUsecase idempotencyusecase.IdempotencyUsecase
+}
+
+func NewRegistry(idempotencyUsecase idempotencyusecase.IdempotencyUsecase) *Registry {
+	return &Registry{
+		IdempotencyUsecase: idempotencyUsecase,
+	}
+}
+
+func (r *Registry) Add(subscriptions ...Subscription) {
+	r.Subscriptions = append(r.Subscriptions, subscriptions...)
+}
+
+func (r *Registry) topics() []topic.Topic {
+	topics := []topic.Topic{}
+	for _, sub := range r.Subscriptions {
+		for _, _topic := range sub.Topics() {
+			if !slices.Contains(topics, _topic) {
+				topics = append(topics, _topic)
+			}
+		}
+	}
+	return topics
+}
+
+// Validate reports every subscription that can not start, every consumer group used more
+// than once (derived groups included) and every group of declaredGroups that no
+// subscription binds, or that a subscription binds without it being declared.
+func (r *Registry) Validate(declaredGroups []string) error {
+	errs := []error{}
+
+	seen := map[string]bool{}
+	use := func(consumerGroup string) {
+		if seen[consumerGroup] {
+			errs = append(errs, fmt.Errorf("consumer group %q is used more than once", consumerGroup))
+		}
+		seen[consumerGroup] = true
+	}
+
+	for _, sub := range r.Subscriptions {
+		if sub.ConsumerGroup == "" {
+			errs = append(errs, fmt.Errorf("subscription on topic %q has no consumer group", sub.Topic.Primary))
+			continue
+		}
+		for _, _topic := range sub.Topics() {
+			if _, ok := topic.Find(_topic.Primary); !ok {
+				errs = append(errs, fmt.Errorf("consumer group %q subscribes to unknown topic %q", sub.ConsumerGroup, _topic.Primary))
+			}
+		}
+
+		switch sub.Mode {
+		case ModeSingle:
+			if sub.Single == nil {
+				errs = append(errs, fmt.Errorf("consumer group %q is single mode but has no single handler", sub.ConsumerGroup))
+			}
+		case ModeBatch:
+			if sub.Batch == nil {
+				errs = append(errs, fmt.Errorf("consumer group %q is batch mode but has no batch handler", sub.ConsumerGroup))
+			}
+			if sub.Single == nil {
+				errs = append(errs, fmt.Errorf("consumer group %q is batch mode but has no single handler for its retry consumer", sub.ConsumerGroup))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("consumer group %q has unknown mode %q", sub.ConsumerGroup, sub.Mode))
+		}
+
+		use(sub.ConsumerGroup)
+		use(sub.RetryConsumerGroup())
+
+		if !slices.Contains(declaredGroups, sub.ConsumerGroup) {
+			errs = append(errs, fmt.Errorf("consumer group %q is bound but not declared", sub.ConsumerGroup))
+		}
+	}
+
+	use(DLQConsumerGroup)
+
+	for _, consumerGroup := range declaredGroups {
+		if !seen[consumerGroup] {
+			errs = append(errs, fmt.Errorf("consumer group %q is declared but not bound", consumerGroup))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*Registry).Validate")
+	}
+	return nil
+}
+
+// chainSingle wraps handler with the middleware every consumer gets. The limit comes first,
+// so time spent waiting for a slot is not counted as handling. Tracing, metrics and logging
+// come next so they see the final outcome, timeout included. Timeout runs the rest in its
+// own goroutine, so recovery comes right inside it, and again innermost so idempotency sees
+// a panic as an error and releases the key. Idempotency keys are claimed under
+// sub.ConsumerGroup even for the retry consumer, so a retried record is skipped only if its
+// own group handled it.
+func (r *Registry) chainSingle(cfg *config.Config, limiter Limiter, consumerGroup string, sub Subscription, handler ConsumerHandlerSingle) ConsumerHandlerSingle {
+	mws := []MiddlewareSingle{
+		LimitSingle(limiter),
+		TracingSingle(consumerGroup),
+		MetricsSingle(consumerGroup),
+		LoggingSingle(consumerGroup),
+		TimeoutSingle(consumerGroup, handlerTimeout(cfg), handlerGrace(cfg)),
+		RecoverSingle(),
+	}
+	if sub.Idempotent {
+		mws = append(mws, IdempotencySingle(r.IdempotencyUsecase, sub.ConsumerGroup), RecoverSingle())
+	}
+	return ChainSingle(handler, mws...)
+}
+
+// chainBatch is chainSingle for batch handlers.
+func (r *Registry) chainBatch(cfg *config.Config, limiter Limiter, consumerGroup string, sub Subscription, handler ConsumerHandlerBatch) ConsumerHandlerBatch {
+	mws := []MiddlewareBatch{
+		LimitBatch(limiter),
+		TracingBatch(consumerGroup),
+		MetricsBatch(consumerGroup),
+		LoggingBatch(consumerGroup),
+		TimeoutBatch(consumerGroup, handlerTimeout(cfg), handlerGrace(cfg)),
+		RecoverBatch(),
+	}
+	if sub.Idempotent {
+		mws = append(mws, IdempotencyBatch(r.IdempotencyUsecase, sub.ConsumerGroup), RecoverBatch())
+	}
+	return ChainBatch(handler, mws...)
+}
+
+// Start runs, in wg, the primary and retry consumer of every subscription and the DLQ
+// consumer of the subscribed topics, until ctx is done. Their handlers share a limit of
+// config.GetKafkaConsumerConcurrency running at once. Call Validate first.
+func (r *Registry) Start(ctx context.Context, cfg *config.Config, producer *kgo.Client, wg *sync.WaitGroup) {
+	limiter := NewLimiter(cfg.GetKafkaConsumerConcurrency())
+
+	for _, sub := range r.Subscriptions {
+		switch sub.Mode {
+		case ModeSingle:
+			handler := r.chainSingle(cfg, limiter, sub.ConsumerGroup, sub, sub.Single)
+			wg.Go(func() { ConsumeEventSingle(ctx, cfg, producer, sub.ConsumerGroup, sub.Topics(), handler) })
+		case ModeBatch:
+			handler := r.chainBatch(cfg, limiter, sub.ConsumerGroup, sub, sub.Batch)
+			wg.Go(func() { ConsumeEventBatch(ctx, cfg, producer, sub.ConsumerGroup, sub.Topics(), handler) })
+		}
+
+		retryHandler := r.chainSingle(cfg, limiter, sub.RetryConsumerGroup(), sub, sub.Single)
+		wg.Go(func() { ConsumeEventRetry(ctx, cfg, producer, sub.RetryConsumerGroup(), sub.Topics(), retryHandler) })
+	}
+
+	if topics := r.topics(); len(topics) > 0 {
+		wg.Go(func() { ConsumeEventDLQ(ctx, cfg, DLQConsumerGroup, topics) })
+	}
+}
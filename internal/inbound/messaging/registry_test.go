@@ -0,0 +1,224 @@
+package messaging_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func noopSingle(ctx context.Context, record *kgo.Record) error {
+	return nil
+}
+
+func noopBatch(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+	return messaging.NewBatchResult(len(records))
+}
+
+func TestRegistry_Validate_Success(t *testing.T) {
+	registry := messaging.NewRegistry(nil)
+	registry.Add(
+		messaging.Subscription{Topic: topic.ImageLiked, ConsumerGroup: "a", Mode: messaging.ModeSingle, Single: noopSingle},
+		messaging.Subscription{Topic: topic.ImageLiked, ConsumerGroup: "b", Mode: messaging.ModeBatch, Batch: noopBatch, Single: noopSingle},
+	)
+
+	err := registry.Validate([]string{"a", "b"})
+
+	require.NoError(t, err)
+}
+
+func TestRegistry_Validate_Fail(t *testing.T) {
+	registry := messaging.NewRegistry(nil)
+	registry.Add(
+		// duplicate group
+		messaging.Subscription{Topic: topic.ImageLiked, ConsumerGroup: "a", Mode: messaging.ModeSingle, Single: noopSingle},
+		messaging.Subscription{Topic: topic.ImageUploaded, ConsumerGroup: "a", Mode: messaging.ModeSingle, Single: noopSingle},
+		// a declared group colliding with a derived retry group
+		messaging.Subscription{Topic: topic.ImageLiked, ConsumerGroup: "a.retry", Mode: messaging.ModeSingle, Single: noopSingle},
+		// batch without the single handler its retry consumer needs
+		messaging.Subscription{Topic: topic.ImageLiked, ConsumerGroup: "b", Mode: messaging.ModeBatch, Batch: noopBatch},
+		// unknown topic, not declared
+		messaging.Subscription{Topic: topic.Topic{Primary: "nope"}, ConsumerGroup: "c", Mode: messaging.ModeSingle, Single: noopSingle},
+		// unknown extra topic
+		messaging.Subscription{Topic: topic.ImageLiked, ExtraTopics: []topic.Topic{{Primary: "nope.extra"}}, ConsumerGroup: "d", Mode: messaging.ModeSingle, Single: noopSingle},
+	)
+
+	err := registry.Validate([]string{"a", "a.retry", "b", "d", "unbound"})
+
+	require.Error(t, err)
+	for _, msg := range []string{
+		`consumer group "a" is used more than once`,
+		`consumer group "a.retry" is used more than once`,
+		`consumer group "b" is batch mode but has no single handler for its retry consumer`,
+		`consumer group "c" subscribes to unknown topic "nope"`,
+		`consumer group "c" is bound but not declared`,
+		`consumer group "d" subscribes to unknown topic "nope.extra"`,
+		`consumer group "unbound" is declared but not bound`,
+	} {
+		require.ErrorContains(t, err, msg)
+	}
+}
+
+func TestRegistry_Start_BatchFailureIsRetriedBySingleHandler(t *testing.T) {
+	_topic := topic.ImageLiked
+	cfg, producer := newFakeKafka(t, []string{_topic.Primary, _topic.Retry(1), _topic.DLQ()})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+
+	retried := make(chan string, 1)
+	registry := messaging.NewRegistry(nil)
+	registry.Add(messaging.Subscription{
+		Topic:         _topic,
+		ConsumerGroup: "test.batch",
+		Mode:          messaging.ModeBatch,
+		Batch: func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+			result := messaging.NewBatchResult(len(records))
+			for i := range result {
+				result[i] = assert.AnError
+			}
+			return result
+		},
+		Single: func(ctx context.Context, record *kgo.Record) error {
+			retried <- record.Topic
+			return nil
+		},
+	})
+	require.NoError(t, registry.Validate([]string{"test.batch"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	registry.Start(ctx, cfg, producer, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(), &kgo.Record{Topic: _topic.Primary, Value: []byte(`{}`)}).FirstErr()
+	require.NoError(t, err)
+
+	require.Equal(t, _topic.Retry(1), <-retried)
+}
+
+func TestRegistry_Start_ExtraTopicsShareTheBatchAndRetryOnTheirOwnTopic(t *testing.T) {
+	topicA := topic.ImageLiked
+	topicB := topic.ImageUnliked
+	cfg, producer := newFakeKafka(t, []string{
+		topicA.Primary, topicA.Retry(1), topicA.DLQ(),
+		topicB.Primary, topicB.Retry(1), topicB.DLQ(),
+	})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+
+	batched := make(chan []string, 10)
+	retried := make(chan string, 1)
+	registry := messaging.NewRegistry(nil)
+	registry.Add(messaging.Subscription{
+		Topic:         topicA,
+		ExtraTopics:   []topic.Topic{topicB},
+		ConsumerGroup: "test.batch",
+		Mode:          messaging.ModeBatch,
+		Batch: func(ctx context.Context, records []*kgo.Record) messaging.BatchResult {
+			result := messaging.NewBatchResult(len(records))
+			topics := []string{}
+			for i, record := range records {
+				topics = append(topics, record.Topic)
+				if record.Topic == topicB.Primary {
+					result[i] = assert.AnError
+				}
+			}
+			batched <- topics
+			return result
+		},
+		Single: func(ctx context.Context, record *kgo.Record) error {
+			retried <- record.Topic
+			return nil
+		},
+	})
+	require.NoError(t, registry.Validate([]string{"test.batch"}))
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topicA.Primary, Value: []byte(`{}`)},
+		&kgo.Record{Topic: topicB.Primary, Value: []byte(`{}`)},
+	).FirstErr()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	registry.Start(ctx, cfg, producer, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	seen := []string{}
+	for len(seen) < 2 {
+		seen = append(seen, <-batched...)
+	}
+	require.ElementsMatch(t, []string{topicA.Primary, topicB.Primary}, seen)
+	require.Equal(t, topicB.Retry(1), <-retried)
+}
+
+func TestRegistry_Start_ConcurrencyIsSharedByConsumers(t *testing.T) {
+	topicA := topic.ImageLiked
+	topicB := topic.ImageUploaded
+	cfg, producer := newFakeKafka(t, []string{
+		topicA.Primary, topicA.Retry(1), topicA.DLQ(),
+		topicB.Primary, topicB.Retry(1), topicB.DLQ(),
+	})
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+	cfg.Set(config.KafkaConsumerConcurrency, 1)
+
+	mu := sync.Mutex{}
+	inFlight, maxInFlight := 0, 0
+	handled := make(chan struct{}, 4)
+	handler := func(ctx context.Context, record *kgo.Record) error {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		handled <- struct{}{}
+		return nil
+	}
+
+	registry := messaging.NewRegistry(nil)
+	registry.Add(
+		messaging.Subscription{Topic: topicA, ConsumerGroup: "test.a", Mode: messaging.ModeSingle, Single: handler},
+		messaging.Subscription{Topic: topicB, ConsumerGroup: "test.b", Mode: messaging.ModeSingle, Single: handler},
+	)
+	require.NoError(t, registry.Validate([]string{"test.a", "test.b"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	registry.Start(ctx, cfg, producer, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topicA.Primary, Value: []byte("1")},
+		&kgo.Record{Topic: topicB.Primary, Value: []byte("2")},
+		&kgo.Record{Topic: topicA.Primary, Value: []byte("3")},
+		&kgo.Record{Topic: topicB.Primary, Value: []byte("4")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	for range 4 {
+		<-handled
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 1, maxInFlight)
+}
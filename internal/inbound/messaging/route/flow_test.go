@@ -0,0 +1,223 @@
+package route_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dependency_injection"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging/route"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	outmessaging "github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/provider"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/idempotencyusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/consumergroup"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// flow runs the image.liked flow in process: ImageProducer writes to an in-memory outbox,
+// the outbox producer sends it to an in-process Kafka cluster and every consumer of the
+// route registry, with its retry and DLQ consumers, reads from that cluster.
+type flow struct {
+	cfg            *config.Config
+	db             *gorm.DB
+	mockDB         sqlmock.Sqlmock
+	outbox         *repository.OutboxRepositoryMemory
+	idempotency    *repository.IdempotencyRepositoryMemory
+	imageProducer  *outmessaging.ImageProducerImpl
+	outboxProducer *outmessaging.OutboxProducerImpl
+}
+
+func newFlow(t *testing.T, imageUsecase *mock.ImageUsecaseMock) *flow {
+	t.Helper()
+
+	cfg := config.NewConfig()
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{1})
+	cfg.Set(config.KafkaConsumerMaxRetries, 1)
+	cfg.Set(config.KafkaProducerEnabled, true)
+
+	cluster := provider.NewKafkaFakeCluster(cfg)
+	t.Cleanup(cluster.Close)
+
+	producer := provider.NewKafkaClientProducer(cfg)
+	t.Cleanup(producer.Close)
+
+	var sqlDB *sql.DB
+	sqlDB, mockDB, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB, PreferSimpleProtocol: true}), &gorm.Config{})
+	require.NoError(t, err)
+
+	outbox := repository.NewOutboxRepositoryMemory()
+	idempotency := repository.NewIdempotencyRepositoryMemory()
+
+	consumers := &dependency_injection.Consumers{
+		ImageConsumer:      messaging.NewImageConsumer(imageUsecase),
+		NotifConsumer:      messaging.NewNotifConsumer(&mock.NotifUsecaseMock{}),
+		UserConsumer:       messaging.NewUserConsumer(&mock.UserUsecaseMock{}),
+		IdempotencyUsecase: idempotencyusecase.NewIdempotencyUsecase(cfg, db, idempotency),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	require.NoError(t, route.Setup(ctx, cfg, producer, consumers, wg))
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	return &flow{
+		cfg:            cfg,
+		db:             db,
+		mockDB:         mockDB,
+		outbox:         outbox,
+		idempotency:    idempotency,
+		imageProducer:  outmessaging.NewImageProducer(cfg, outbox),
+		outboxProducer: outmessaging.NewOutboxProducer(cfg, db, producer, outbox),
+	}
+}
+
+// produceOutbox runs one cycle of the outbox producer.
+func (f *flow) produceOutbox(t *testing.T) {
+	t.Helper()
+
+	f.mockDB.ExpectBegin()
+	f.mockDB.ExpectCommit()
+	require.NoError(t, f.outboxProducer.ProducePending(context.Background()))
+	require.NoError(t, f.mockDB.ExpectationsWereMet())
+}
+
+func (f *flow) sendImageLiked(t *testing.T, event dto.ImageLikedEvent) {
+	t.Helper()
+
+	require.NoError(t, f.imageProducer.SendImageLiked(context.Background(), f.db, &event))
+	f.produceOutbox(t)
+
+	for _, outbox := range f.outbox.List() {
+		require.Equal(t, entity.OutboxStatusProduced, outbox.Status)
+	}
+}
+
+func newImageUsecase(notifyUserImageLiked func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error) *mock.ImageUsecaseMock {
+	return &mock.ImageUsecaseMock{
+		NotifyUserImageLikedFunc: notifyUserImageLiked,
+		BatchUpdateImageLikeCountFunc: func(ctx context.Context, req dto.BatchUpdateImageLikeCountRequest) error {
+			return nil
+		},
+	}
+}
+
+func TestFlow_ImageLiked_NotifiesOwner(t *testing.T) {
+	notified := make(chan dto.NotifyUserImageLikedRequest, 1)
+	f := newFlow(t, newImageUsecase(func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error {
+		notified <- req
+		return nil
+	}))
+
+	f.sendImageLiked(t, dto.ImageLikedEvent{ID: 3, UserID: 2, ImageID: 1})
+
+	select {
+	case req := <-notified:
+		require.Equal(t, int64(1), req.ImageID)
+		require.Equal(t, int64(2), req.LikerUserID)
+	case <-time.After(30 * time.Second):
+		t.Fatal("owner was not notified")
+	}
+}
+
+func TestFlow_ImageLiked_EveryConsumerGroupHandlesTheEvent(t *testing.T) {
+	notified := make(chan dto.NotifyUserImageLikedRequest, 1)
+	counted := make(chan dto.BatchUpdateImageLikeCountRequest, 1)
+	imageUsecase := newImageUsecase(func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error {
+		notified <- req
+		return nil
+	})
+	imageUsecase.BatchUpdateImageLikeCountFunc = func(ctx context.Context, req dto.BatchUpdateImageLikeCountRequest) error {
+		counted <- req
+		return nil
+	}
+	f := newFlow(t, imageUsecase)
+
+	f.sendImageLiked(t, dto.ImageLikedEvent{ID: 3, UserID: 2, ImageID: 1})
+
+	// both groups consume image.liked with idempotency on and the record carries one key,
+	// the second group must not see it as a duplicate of the first
+	select {
+	case <-notified:
+	case <-time.After(30 * time.Second):
+		t.Fatal("owner was not notified")
+	}
+	select {
+	case <-counted:
+	case <-time.After(30 * time.Second):
+		t.Fatal("like count was not updated")
+	}
+
+	groups := []string{}
+	for _, record := range f.idempotency.List() {
+		groups = append(groups, record.ConsumerGroup)
+	}
+	require.ElementsMatch(t, []string{consumergroup.ImageLikedNotifyOwner, consumergroup.ImageLikedBatchCount}, groups)
+}
+
+func TestFlow_ImageLiked_RetriesThenNotifiesOwner(t *testing.T) {
+	calls := atomic.Int32{}
+	f := newFlow(t, newImageUsecase(func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error {
+		if calls.Add(1) == 1 {
+			return errors.New("notification service unavailable")
+		}
+		return nil
+	}))
+
+	f.sendImageLiked(t, dto.ImageLikedEvent{ID: 3, UserID: 2, ImageID: 1})
+
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, 30*time.Second, 50*time.Millisecond)
+}
+
+func TestFlow_ImageLiked_NonRetryableGoesToDLQ(t *testing.T) {
+	f := newFlow(t, newImageUsecase(func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error {
+		return errkit.WrapNonRetryable(errors.New("image owner does not exist"))
+	}))
+
+	f.sendImageLiked(t, dto.ImageLikedEvent{ID: 3, UserID: 2, ImageID: 1})
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(strings.Split(f.cfg.GetKafkaBootstrapServers(), ",")...),
+		kgo.ConsumeTopics(topic.ImageLiked.DLQ()),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	fetches := client.PollRecords(ctx, 1)
+	require.Empty(t, fetches.Errors())
+	records := fetches.Records()
+	require.Len(t, records, 1)
+
+	consumerGroup := ""
+	for _, h := range records[0].Headers {
+		if h.Key == header.ErrorConsumerGroup {
+			consumerGroup = string(h.Value)
+		}
+	}
+	require.Equal(t, consumergroup.ImageLikedNotifyOwner, consumerGroup)
+}
@@ -5,145 +5,157 @@ import (
 	"sync"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dependency_injection"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/consumergroup"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/twmb/franz-go/pkg/kgo"
 )
 
-func Setup(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumers *dependency_injection.Consumers, wg *sync.WaitGroup) {
-	// --- primary consumers: single ---
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.UserFollowedNotifyUser
-		_topic := topic.UserFollowed
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.UserConsumer.NotifyUserBeingFollowed)
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageUploadedNotifyFollowers
-		_topic := topic.ImageUploaded
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyFollowerOnUpload)
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageUploadedSyncSearch
-		_topic := topic.ImageUploaded
-		handler := consumers.ImageConsumer.SyncImageToElasticsearch
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageLikedNotifyOwner
-		_topic := topic.ImageLiked
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyUserImageLiked)
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageCommentedNotifyOwner
-		_topic := topic.ImageCommented
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyUserImageCommented)
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.NotifLog
-		_topic := topic.Notif
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.NotifConsumer.Notify)
-		messaging.ConsumeEventSingle(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	// --- primary consumers: batch ---
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.UserFollowedBatchStats
-		_topic := topic.UserFollowed
-		handler := messaging.IdempotencyHandlerBatch(consumers.IdempotencyUsecase, consumers.UserConsumer.BatchUpdateUserFollowStats)
-		messaging.ConsumeEventBatch(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageLikedBatchCount
-		_topic := topic.ImageLiked
-		handler := messaging.IdempotencyHandlerBatch(consumers.IdempotencyUsecase, consumers.ImageConsumer.BatchUpdateImageLikeCount)
-		messaging.ConsumeEventBatch(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageCommentedBatchCount
-		_topic := topic.ImageCommented
-		handler := messaging.IdempotencyHandlerBatch(consumers.IdempotencyUsecase, consumers.ImageConsumer.BatchUpdateImageCommentCount)
-		messaging.ConsumeEventBatch(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	// --- retry consumers: single handlers ---
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.UserFollowedNotifyUserRetry
-		_topic := topic.UserFollowed
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.UserConsumer.NotifyUserBeingFollowed)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageUploadedNotifyFollowersRetry
-		_topic := topic.ImageUploaded
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyFollowerOnUpload)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageUploadedSyncSearchRetry
-		_topic := topic.ImageUploaded
-		handler := consumers.ImageConsumer.SyncImageToElasticsearch
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageLikedNotifyOwnerRetry
-		_topic := topic.ImageLiked
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyUserImageLiked)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageCommentedNotifyOwnerRetry
-		_topic := topic.ImageCommented
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.NotifyUserImageCommented)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
-
-	wg.Go(func() {
-		consumerGroup := consumergroup.NotifLogRetry
-		_topic := topic.Notif
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.NotifConsumer.Notify)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
+// NewRegistry declares every subscription once, their retry and DLQ consumers are derived
+// by the registry.
+func NewRegistry(consumers *dependency_injection.Consumers) *messaging.Registry {
+	registry := messaging.NewRegistry(consumers.IdempotencyUsecase)
+
+	// --- single ---
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.UserFollowed,
+		ConsumerGroup: consumergroup.UserFollowedNotifyUser,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    true,
+		Single:        messaging.DecodeSingle(eventschema.UserFollowed, consumers.UserConsumer.NotifyUserBeingFollowed),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageUploaded,
+		ConsumerGroup: consumergroup.ImageUploadedNotifyFollowers,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    true,
+		Single:        messaging.DecodeSingle(eventschema.ImageUploaded, consumers.ImageConsumer.NotifyFollowerOnUpload),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageUploaded,
+		ConsumerGroup: consumergroup.ImageUploadedSyncSearch,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    false, // indexing the same document twice is harmless
+		Single:        messaging.DecodeSingle(eventschema.ImageUploaded, consumers.ImageConsumer.SyncImageToElasticsearch),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageUpdated,
+		ConsumerGroup: consumergroup.ImageUpdatedSyncSearch,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    false, // the document is replaced under the image id, so reindexing is harmless
+		Single:        messaging.DecodeSingle(eventschema.ImageUpdated, consumers.ImageConsumer.SyncUpdatedImageToElasticsearch),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageDeleted,
+		ConsumerGroup: consumergroup.ImageDeletedSyncStorage,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    false, // deleting an object that is already gone succeeds
+		Single:        messaging.DecodeSingle(eventschema.ImageDeleted, consumers.ImageConsumer.DeleteImageObject),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageDeleted,
+		ConsumerGroup: consumergroup.ImageDeletedSyncSearch,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    false, // deleting documents that are already gone succeeds
+		Single:        messaging.DecodeSingle(eventschema.ImageDeleted, consumers.ImageConsumer.RemoveImageFromElasticsearch),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageLiked,
+		ConsumerGroup: consumergroup.ImageLikedNotifyOwner,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    true,
+		Single:        messaging.DecodeSingle(eventschema.ImageLiked, consumers.ImageConsumer.NotifyUserImageLiked),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageCommented,
+		ConsumerGroup: consumergroup.ImageCommentedNotifyOwner,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    true,
+		Single:        messaging.DecodeSingle(eventschema.ImageCommented, consumers.ImageConsumer.NotifyUserImageCommented),
+	})
+
+	registry.Add(messaging.Subscription{
+		Topic:         topic.Notif,
+		ConsumerGroup: consumergroup.NotifLog,
+		Mode:          messaging.ModeSingle,
+		Idempotent:    true,
+		Single:        messaging.DecodeSingle(eventschema.Notif, consumers.NotifConsumer.Notify),
+	})
+
+	// --- batch, Single handles the retried records one at a time ---
+
+	// follows and unfollows are consumed by one group, so they are netted per user in a batch
+	userFollowStatsDecoders := []messaging.Decoder[dto.UserFollowChange]{
+		messaging.DecodeAs(eventschema.UserFollowed, converter.DtoUserFollowedEventToDtoUserFollowChange),
+		messaging.DecodeAs(eventschema.UserUnfollowed, converter.DtoUserUnfollowedEventToDtoUserFollowChange),
+	}
+	registry.Add(messaging.Subscription{
+		Topic:         topic.UserFollowed,
+		ExtraTopics:   []topic.Topic{topic.UserUnfollowed},
+		ConsumerGroup: consumergroup.UserFollowedBatchStats,
+		Mode:          messaging.ModeBatch,
+		Idempotent:    true,
+		Batch:         messaging.DecodeBatchOf(consumers.UserConsumer.BatchUpdateUserFollowStats, userFollowStatsDecoders...),
+		Single:        messaging.DecodeSingleOf(consumers.UserConsumer.UpdateUserFollowStats, userFollowStatsDecoders...),
+	})
+
+	// likes and unlikes are consumed by one group, so they are netted per image in a batch
+	imageLikeCountDecoders := []messaging.Decoder[dto.ImageIncreaseLikeCount]{
+		messaging.DecodeAs(eventschema.ImageLiked, converter.DtoImageLikedEventToDtoImageIncreaseLikeCount),
+		messaging.DecodeAs(eventschema.ImageUnliked, converter.DtoImageUnlikedEventToDtoImageIncreaseLikeCount),
+	}
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageLiked,
+		ExtraTopics:   []topic.Topic{topic.ImageUnliked},
+		ConsumerGroup: consumergroup.ImageLikedBatchCount,
+		Mode:          messaging.ModeBatch,
+		Idempotent:    true,
+		Batch:         messaging.DecodeBatchOf(consumers.ImageConsumer.BatchUpdateImageLikeCount, imageLikeCountDecoders...),
+		Single:        messaging.DecodeSingleOf(consumers.ImageConsumer.UpdateImageLikeCount, imageLikeCountDecoders...),
+	})
+
+	// added and deleted comments are consumed by one group, so they are netted per image in a batch
+	imageCommentCountDecoders := []messaging.Decoder[dto.ImageIncreaseCommentCount]{
+		messaging.DecodeAs(eventschema.ImageCommented, converter.DtoImageCommentedEventToDtoImageIncreaseCommentCount),
+		messaging.DecodeAs(eventschema.ImageCommentDeleted, converter.DtoImageCommentDeletedEventToDtoImageIncreaseCommentCount),
+	}
+	registry.Add(messaging.Subscription{
+		Topic:         topic.ImageCommented,
+		ExtraTopics:   []topic.Topic{topic.ImageCommentDeleted},
+		ConsumerGroup: consumergroup.ImageCommentedBatchCount,
+		Mode:          messaging.ModeBatch,
+		Idempotent:    true,
+		Batch:         messaging.DecodeBatchOf(consumers.ImageConsumer.BatchUpdateImageCommentCount, imageCommentCountDecoders...),
+		Single:        messaging.DecodeSingleOf(consumers.ImageConsumer.UpdateImageCommentCount, imageCommentCountDecoders...),
+	})
+
+	return registry
+}
 
-	// --- retry consumers: batch handlers ---
+// Setup validates the registry and starts its consumers in wg. Nothing is started when
+// validation fails.
+func Setup(ctx context.Context, cfg *config.Config, producer *kgo.Client, consumers *dependency_injection.Consumers, wg *sync.WaitGroup) error {
+	registry := NewRegistry(consumers)
 
-	wg.Go(func() {
-		consumerGroup := consumergroup.UserFollowedBatchStatsRetry
-		_topic := topic.UserFollowed
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.UserConsumer.UpdateUserFollowStats)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
+	err := registry.Validate(consumergroup.All)
+	if err != nil {
+		return errkit.AddFuncName(err, "route.Setup")
+	}
 
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageLikedBatchCountRetry
-		_topic := topic.ImageLiked
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.UpdateImageLikeCount)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
+	registry.Start(ctx, cfg, producer, wg)
 
-	wg.Go(func() {
-		consumerGroup := consumergroup.ImageCommentedBatchCountRetry
-		_topic := topic.ImageCommented
-		handler := messaging.IdempotencyHandlerSingle(consumers.IdempotencyUsecase, consumers.ImageConsumer.UpdateImageCommentCount)
-		messaging.ConsumeEventRetry(ctx, cfg, producer, consumerGroup, _topic, handler)
-	})
+	return nil
 }
@@ -0,0 +1,23 @@
+package route_test
+
+import (
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dependency_injection"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging/route"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/consumergroup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegistry_BindsEveryDeclaredGroup(t *testing.T) {
+	consumers := &dependency_injection.Consumers{
+		ImageConsumer: &messaging.ImageConsumer{},
+		NotifConsumer: &messaging.NotifConsumer{},
+		UserConsumer:  &messaging.UserConsumer{},
+	}
+
+	err := route.NewRegistry(consumers).Validate(consumergroup.All)
+
+	require.NoError(t, err)
+}
@@ -2,15 +2,11 @@ package messaging
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/userusecase"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
-	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 type UserConsumer struct {
@@ -23,52 +19,46 @@ func NewUserConsumer(usecase userusecase.UserUsecase) *UserConsumer {
 	}
 }
 
-func (c *UserConsumer) NotifyUserBeingFollowed(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	event := dto.UserFollowedEvent{}
-	err := json.Unmarshal(record.Value, &event)
-	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(errkit.WrapNonRetryable(err), "messaging.(*UserConsumer).NotifyUserBeingFollowed")
-	}
-
+func (c *UserConsumer) NotifyUserBeingFollowed(ctx context.Context, event dto.UserFollowedEvent) error {
 	req := dto.NotifyUserBeingFollowedRequest{}
 	converter.DtoUserFollowedEventToDtoNotifyUserBeingFollowedRequest(event, &req)
 
-	err = c.Usecase.NotifyUserBeingFollowed(ctx, req)
+	err := c.Usecase.NotifyUserBeingFollowed(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*UserConsumer).NotifyUserBeingFollowed")
 	}
 
 	return nil
 }
 
-func (c *UserConsumer) BatchUpdateUserFollowStats(ctx context.Context, records []*kgo.Record) error {
-	ctx, span := telemetry.StartConsumerBatch(ctx, records)
-	defer span.End()
+// BatchUpdateUserFollowStats applies the stats changes of follows and unfollows, netted per
+// user, so a user followed then unfollowed within the batch is left untouched.
+func (c *UserConsumer) BatchUpdateUserFollowStats(ctx context.Context, changes dto.UserFollowChangeList) BatchResult {
+	result := BatchResult(applyBatch(ctx, changes, c.applyUserFollowStats))
+	for i, err := range result {
+		if err != nil {
+			result[i] = errkit.AddFuncName(err, "messaging.(*UserConsumer).BatchUpdateUserFollowStats")
+		}
+	}
+
+	return result
+}
 
+func (c *UserConsumer) applyUserFollowStats(ctx context.Context, changes dto.UserFollowChangeList) error {
 	req := dto.BatchUpdateUserFollowStatsRequest{}
-	converter.KGoRecordListToDtoBatchUpdateUserFollowStatsRequest(ctx, records, &req)
+	converter.DtoUserFollowChangeListToDtoBatchUpdateUserFollowStatsRequest(changes, &req)
 
 	err := c.Usecase.BatchUpdateUserFollowStats(ctx, req)
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
-		return errkit.AddFuncName(err, "messaging.(*UserConsumer).BatchUpdateUserFollowStats")
+		return errkit.AddFuncName(err, "messaging.(*UserConsumer).applyUserFollowStats")
 	}
 
 	return nil
 }
 
-func (c *UserConsumer) UpdateUserFollowStats(ctx context.Context, record *kgo.Record) error {
-	ctx, span := telemetry.StartConsumer(ctx, record)
-	defer span.End()
-
-	err := c.BatchUpdateUserFollowStats(ctx, []*kgo.Record{record})
+func (c *UserConsumer) UpdateUserFollowStats(ctx context.Context, change dto.UserFollowChange) error {
+	err := c.applyUserFollowStats(ctx, dto.UserFollowChangeList{change})
 	if err != nil {
-		logkit.Logger.WithContext(ctx).WithError(err).Error()
 		return errkit.AddFuncName(err, "messaging.(*UserConsumer).UpdateUserFollowStats")
 	}
 
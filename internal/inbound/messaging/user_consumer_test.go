@@ -0,0 +1,66 @@
+package messaging_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/inbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestUserConsumer_BatchUpdateUserFollowStats_NetsFollowsAndUnfollows(t *testing.T) {
+	applied := map[int64]dto.UserIncreaseFollowerFollowingCount{}
+	Usecase := &mock.UserUsecaseMock{
+		BatchUpdateUserFollowStatsFunc: func(ctx context.Context, req dto.BatchUpdateUserFollowStatsRequest) error {
+			for _, v := range req.UserIncreaseFollowerFollowingCountList {
+				applied[v.UserID] = v
+			}
+			return nil
+		},
+	}
+	c := messaging.NewUserConsumer(Usecase)
+
+	decoders := []messaging.Decoder[dto.UserFollowChange]{
+		messaging.DecodeAs(eventschema.UserFollowed, converter.DtoUserFollowedEventToDtoUserFollowChange),
+		messaging.DecodeAs(eventschema.UserUnfollowed, converter.DtoUserUnfollowedEventToDtoUserFollowChange),
+	}
+	records := []*kgo.Record{
+		{Value: []byte(`{"specversion":"1.0","type":"user.followed","schemaversion":1,"data":{"follower_id":1,"following_id":2}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"user.unfollowed","schemaversion":1,"data":{"follower_id":1,"following_id":2}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"user.unfollowed","schemaversion":1,"data":{"follower_id":3,"following_id":2}}`)},
+		{Value: []byte(`{"specversion":"1.0","type":"user.unfollowed","schemaversion":1,"data":{"follower_id":2,"following_id":1}}`)},
+	}
+
+	result := messaging.DecodeBatchOf(c.BatchUpdateUserFollowStats, decoders...)(context.Background(), records)
+
+	require.NoError(t, result.Err())
+	require.Equal(t, map[int64]dto.UserIncreaseFollowerFollowingCount{
+		1: {UserID: 1, FollowerCount: -1, FollowingCount: 0},
+		2: {UserID: 2, FollowerCount: -1, FollowingCount: -1},
+		3: {UserID: 3, FollowerCount: 0, FollowingCount: -1},
+	}, applied)
+}
+
+func TestUserConsumer_BatchUpdateUserFollowStats_LeavesOutNettedUsers(t *testing.T) {
+	var got dto.BatchUpdateUserFollowStatsRequest
+	Usecase := &mock.UserUsecaseMock{
+		BatchUpdateUserFollowStatsFunc: func(ctx context.Context, req dto.BatchUpdateUserFollowStatsRequest) error {
+			got = req
+			return nil
+		},
+	}
+	c := messaging.NewUserConsumer(Usecase)
+
+	result := c.BatchUpdateUserFollowStats(context.Background(), dto.UserFollowChangeList{
+		{FollowerID: 1, FollowingID: 2, Count: 1},
+		{FollowerID: 1, FollowingID: 2, Count: -1},
+	})
+
+	require.NoError(t, result.Err())
+	require.Empty(t, got.UserIncreaseFollowerFollowingCountList)
+}
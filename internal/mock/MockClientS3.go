@@ -20,6 +20,9 @@ var _ storage.S3Client = &S3ClientMock{}
 //
 //		// make and configure a mocked storage.S3Client
 //		mockedS3Client := &S3ClientMock{
+//			DeleteImageFunc: func(ctx context.Context, req dto.S3DeleteImageRequest) error {
+//				panic("mock out the DeleteImage method")
+//			},
 //			DeleteObjectFunc: func(ctx context.Context, req dto.S3DeleteObjectRequest) (dto.S3DeleteObjectResponse, error) {
 //				panic("mock out the DeleteObject method")
 //			},
@@ -36,6 +39,9 @@ var _ storage.S3Client = &S3ClientMock{}
 //
 //	}
 type S3ClientMock struct {
+	// DeleteImageFunc mocks the DeleteImage method.
+	DeleteImageFunc func(ctx context.Context, req dto.S3DeleteImageRequest) error
+
 	// DeleteObjectFunc mocks the DeleteObject method.
 	DeleteObjectFunc func(ctx context.Context, req dto.S3DeleteObjectRequest) (dto.S3DeleteObjectResponse, error)
 
@@ -47,6 +53,13 @@ type S3ClientMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// DeleteImage holds details about calls to the DeleteImage method.
+		DeleteImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.S3DeleteImageRequest
+		}
 		// DeleteObject holds details about calls to the DeleteObject method.
 		DeleteObject []struct {
 			// Ctx is the ctx argument value.
@@ -69,11 +82,48 @@ type S3ClientMock struct {
 			Req dto.S3UploadImageRequest
 		}
 	}
+	lockDeleteImage  sync.RWMutex
 	lockDeleteObject sync.RWMutex
 	lockDownload     sync.RWMutex
 	lockUploadImage  sync.RWMutex
 }
 
+// DeleteImage calls DeleteImageFunc.
+func (mock *S3ClientMock) DeleteImage(ctx context.Context, req dto.S3DeleteImageRequest) error {
+	if mock.DeleteImageFunc == nil {
+		panic("S3ClientMock.DeleteImageFunc: method is nil but S3Client.DeleteImage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.S3DeleteImageRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeleteImage.Lock()
+	mock.calls.DeleteImage = append(mock.calls.DeleteImage, callInfo)
+	mock.lockDeleteImage.Unlock()
+	return mock.DeleteImageFunc(ctx, req)
+}
+
+// DeleteImageCalls gets all the calls that were made to DeleteImage.
+// Check the length with:
+//
+//	len(mockedS3Client.DeleteImageCalls())
+func (mock *S3ClientMock) DeleteImageCalls() []struct {
+	Ctx context.Context
+	Req dto.S3DeleteImageRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.S3DeleteImageRequest
+	}
+	mock.lockDeleteImage.RLock()
+	calls = mock.calls.DeleteImage
+	mock.lockDeleteImage.RUnlock()
+	return calls
+}
+
 // DeleteObject calls DeleteObjectFunc.
 func (mock *S3ClientMock) DeleteObject(ctx context.Context, req dto.S3DeleteObjectRequest) (dto.S3DeleteObjectResponse, error) {
 	if mock.DeleteObjectFunc == nil {
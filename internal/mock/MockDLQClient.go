@@ -0,0 +1,165 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"sync"
+	"time"
+)
+
+// Ensure, that DLQClientMock does implement messaging.DLQClient.
+// If this is not the case, regenerate this file with moq.
+var _ messaging.DLQClient = &DLQClientMock{}
+
+// DLQClientMock is a mock implementation of messaging.DLQClient.
+//
+//	func TestSomethingThatUsesDLQClient(t *testing.T) {
+//
+//		// make and configure a mocked messaging.DLQClient
+//		mockedDLQClient := &DLQClientMock{
+//			ReadFunc: func(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error {
+//				panic("mock out the Read method")
+//			},
+//			ReplayFunc: func(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+//				panic("mock out the Replay method")
+//			},
+//		}
+//
+//		// use mockedDLQClient in code that requires messaging.DLQClient
+//		// and then make assertions.
+//
+//	}
+type DLQClientMock struct {
+	// ReadFunc mocks the Read method.
+	ReadFunc func(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error
+
+	// ReplayFunc mocks the Replay method.
+	ReplayFunc func(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Read holds details about calls to the Read method.
+		Read []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TopicName is the topicName argument value.
+			TopicName topic.Topic
+			// Since is the since argument value.
+			Since time.Time
+			// Until is the until argument value.
+			Until time.Time
+			// Limit is the limit argument value.
+			Limit int
+			// Records is the records argument value.
+			Records *dto.DLQRecordList
+		}
+		// Replay holds details about calls to the Replay method.
+		Replay []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TopicName is the topicName argument value.
+			TopicName topic.Topic
+			// Records is the records argument value.
+			Records dto.DLQRecordList
+		}
+	}
+	lockRead   sync.RWMutex
+	lockReplay sync.RWMutex
+}
+
+// Read calls ReadFunc.
+func (mock *DLQClientMock) Read(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error {
+	if mock.ReadFunc == nil {
+		panic("DLQClientMock.ReadFunc: method is nil but DLQClient.Read was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		TopicName topic.Topic
+		Since     time.Time
+		Until     time.Time
+		Limit     int
+		Records   *dto.DLQRecordList
+	}{
+		Ctx:       ctx,
+		TopicName: topicName,
+		Since:     since,
+		Until:     until,
+		Limit:     limit,
+		Records:   records,
+	}
+	mock.lockRead.Lock()
+	mock.calls.Read = append(mock.calls.Read, callInfo)
+	mock.lockRead.Unlock()
+	return mock.ReadFunc(ctx, topicName, since, until, limit, records)
+}
+
+// ReadCalls gets all the calls that were made to Read.
+// Check the length with:
+//
+//	len(mockedDLQClient.ReadCalls())
+func (mock *DLQClientMock) ReadCalls() []struct {
+	Ctx       context.Context
+	TopicName topic.Topic
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+	Records   *dto.DLQRecordList
+} {
+	var calls []struct {
+		Ctx       context.Context
+		TopicName topic.Topic
+		Since     time.Time
+		Until     time.Time
+		Limit     int
+		Records   *dto.DLQRecordList
+	}
+	mock.lockRead.RLock()
+	calls = mock.calls.Read
+	mock.lockRead.RUnlock()
+	return calls
+}
+
+// Replay calls ReplayFunc.
+func (mock *DLQClientMock) Replay(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+	if mock.ReplayFunc == nil {
+		panic("DLQClientMock.ReplayFunc: method is nil but DLQClient.Replay was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		TopicName topic.Topic
+		Records   dto.DLQRecordList
+	}{
+		Ctx:       ctx,
+		TopicName: topicName,
+		Records:   records,
+	}
+	mock.lockReplay.Lock()
+	mock.calls.Replay = append(mock.calls.Replay, callInfo)
+	mock.lockReplay.Unlock()
+	return mock.ReplayFunc(ctx, topicName, records)
+}
+
+// ReplayCalls gets all the calls that were made to Replay.
+// Check the length with:
+//
+//	len(mockedDLQClient.ReplayCalls())
+func (mock *DLQClientMock) ReplayCalls() []struct {
+	Ctx       context.Context
+	TopicName topic.Topic
+	Records   dto.DLQRecordList
+} {
+	var calls []struct {
+		Ctx       context.Context
+		TopicName topic.Topic
+		Records   dto.DLQRecordList
+	}
+	mock.lockReplay.RLock()
+	calls = mock.calls.Replay
+	mock.lockReplay.RUnlock()
+	return calls
+}
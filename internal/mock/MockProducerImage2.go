@@ -21,12 +21,24 @@ var _ messaging.ImageProducer = &ImageProducerMock{}
 //
 //		// make and configure a mocked messaging.ImageProducer
 //		mockedImageProducer := &ImageProducerMock{
+//			SendImageCommentDeletedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+//				panic("mock out the SendImageCommentDeleted method")
+//			},
 //			SendImageCommentedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentedEvent) error {
 //				panic("mock out the SendImageCommented method")
 //			},
+//			SendImageDeletedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+//				panic("mock out the SendImageDeleted method")
+//			},
 //			SendImageLikedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error {
 //				panic("mock out the SendImageLiked method")
 //			},
+//			SendImageUnlikedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+//				panic("mock out the SendImageUnliked method")
+//			},
+//			SendImageUpdatedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+//				panic("mock out the SendImageUpdated method")
+//			},
 //			SendImageUploadedFunc: func(ctx context.Context, db *gorm.DB, event *dto.ImageUploadedEvent) error {
 //				panic("mock out the SendImageUploaded method")
 //			},
@@ -37,17 +49,38 @@ var _ messaging.ImageProducer = &ImageProducerMock{}
 //
 //	}
 type ImageProducerMock struct {
+	// SendImageCommentDeletedFunc mocks the SendImageCommentDeleted method.
+	SendImageCommentDeletedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error
+
 	// SendImageCommentedFunc mocks the SendImageCommented method.
 	SendImageCommentedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentedEvent) error
 
+	// SendImageDeletedFunc mocks the SendImageDeleted method.
+	SendImageDeletedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error
+
 	// SendImageLikedFunc mocks the SendImageLiked method.
 	SendImageLikedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error
 
+	// SendImageUnlikedFunc mocks the SendImageUnliked method.
+	SendImageUnlikedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error
+
+	// SendImageUpdatedFunc mocks the SendImageUpdated method.
+	SendImageUpdatedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error
+
 	// SendImageUploadedFunc mocks the SendImageUploaded method.
 	SendImageUploadedFunc func(ctx context.Context, db *gorm.DB, event *dto.ImageUploadedEvent) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// SendImageCommentDeleted holds details about calls to the SendImageCommentDeleted method.
+		SendImageCommentDeleted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Event is the event argument value.
+			Event *dto.ImageCommentDeletedEvent
+		}
 		// SendImageCommented holds details about calls to the SendImageCommented method.
 		SendImageCommented []struct {
 			// Ctx is the ctx argument value.
@@ -57,6 +90,15 @@ type ImageProducerMock struct {
 			// Event is the event argument value.
 			Event *dto.ImageCommentedEvent
 		}
+		// SendImageDeleted holds details about calls to the SendImageDeleted method.
+		SendImageDeleted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Event is the event argument value.
+			Event *dto.ImageDeletedEvent
+		}
 		// SendImageLiked holds details about calls to the SendImageLiked method.
 		SendImageLiked []struct {
 			// Ctx is the ctx argument value.
@@ -66,6 +108,24 @@ type ImageProducerMock struct {
 			// Event is the event argument value.
 			Event *dto.ImageLikedEvent
 		}
+		// SendImageUnliked holds details about calls to the SendImageUnliked method.
+		SendImageUnliked []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Event is the event argument value.
+			Event *dto.ImageUnlikedEvent
+		}
+		// SendImageUpdated holds details about calls to the SendImageUpdated method.
+		SendImageUpdated []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Event is the event argument value.
+			Event *dto.ImageUpdatedEvent
+		}
 		// SendImageUploaded holds details about calls to the SendImageUploaded method.
 		SendImageUploaded []struct {
 			// Ctx is the ctx argument value.
@@ -76,9 +136,53 @@ type ImageProducerMock struct {
 			Event *dto.ImageUploadedEvent
 		}
 	}
-	lockSendImageCommented sync.RWMutex
-	lockSendImageLiked     sync.RWMutex
-	lockSendImageUploaded  sync.RWMutex
+	lockSendImageCommentDeleted sync.RWMutex
+	lockSendImageCommented      sync.RWMutex
+	lockSendImageDeleted        sync.RWMutex
+	lockSendImageLiked          sync.RWMutex
+	lockSendImageUnliked        sync.RWMutex
+	lockSendImageUpdated        sync.RWMutex
+	lockSendImageUploaded       sync.RWMutex
+}
+
+// SendImageCommentDeleted calls SendImageCommentDeletedFunc.
+func (mock *ImageProducerMock) SendImageCommentDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+	if mock.SendImageCommentDeletedFunc == nil {
+		panic("ImageProducerMock.SendImageCommentDeletedFunc: method is nil but ImageProducer.SendImageCommentDeleted was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageCommentDeletedEvent
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Event: event,
+	}
+	mock.lockSendImageCommentDeleted.Lock()
+	mock.calls.SendImageCommentDeleted = append(mock.calls.SendImageCommentDeleted, callInfo)
+	mock.lockSendImageCommentDeleted.Unlock()
+	return mock.SendImageCommentDeletedFunc(ctx, db, event)
+}
+
+// SendImageCommentDeletedCalls gets all the calls that were made to SendImageCommentDeleted.
+// Check the length with:
+//
+//	len(mockedImageProducer.SendImageCommentDeletedCalls())
+func (mock *ImageProducerMock) SendImageCommentDeletedCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Event *dto.ImageCommentDeletedEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageCommentDeletedEvent
+	}
+	mock.lockSendImageCommentDeleted.RLock()
+	calls = mock.calls.SendImageCommentDeleted
+	mock.lockSendImageCommentDeleted.RUnlock()
+	return calls
 }
 
 // SendImageCommented calls SendImageCommentedFunc.
@@ -121,6 +225,46 @@ func (mock *ImageProducerMock) SendImageCommentedCalls() []struct {
 	return calls
 }
 
+// SendImageDeleted calls SendImageDeletedFunc.
+func (mock *ImageProducerMock) SendImageDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+	if mock.SendImageDeletedFunc == nil {
+		panic("ImageProducerMock.SendImageDeletedFunc: method is nil but ImageProducer.SendImageDeleted was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageDeletedEvent
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Event: event,
+	}
+	mock.lockSendImageDeleted.Lock()
+	mock.calls.SendImageDeleted = append(mock.calls.SendImageDeleted, callInfo)
+	mock.lockSendImageDeleted.Unlock()
+	return mock.SendImageDeletedFunc(ctx, db, event)
+}
+
+// SendImageDeletedCalls gets all the calls that were made to SendImageDeleted.
+// Check the length with:
+//
+//	len(mockedImageProducer.SendImageDeletedCalls())
+func (mock *ImageProducerMock) SendImageDeletedCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Event *dto.ImageDeletedEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageDeletedEvent
+	}
+	mock.lockSendImageDeleted.RLock()
+	calls = mock.calls.SendImageDeleted
+	mock.lockSendImageDeleted.RUnlock()
+	return calls
+}
+
 // SendImageLiked calls SendImageLikedFunc.
 func (mock *ImageProducerMock) SendImageLiked(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error {
 	if mock.SendImageLikedFunc == nil {
@@ -161,6 +305,86 @@ func (mock *ImageProducerMock) SendImageLikedCalls() []struct {
 	return calls
 }
 
+// SendImageUnliked calls SendImageUnlikedFunc.
+func (mock *ImageProducerMock) SendImageUnliked(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+	if mock.SendImageUnlikedFunc == nil {
+		panic("ImageProducerMock.SendImageUnlikedFunc: method is nil but ImageProducer.SendImageUnliked was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageUnlikedEvent
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Event: event,
+	}
+	mock.lockSendImageUnliked.Lock()
+	mock.calls.SendImageUnliked = append(mock.calls.SendImageUnliked, callInfo)
+	mock.lockSendImageUnliked.Unlock()
+	return mock.SendImageUnlikedFunc(ctx, db, event)
+}
+
+// SendImageUnlikedCalls gets all the calls that were made to SendImageUnliked.
+// Check the length with:
+//
+//	len(mockedImageProducer.SendImageUnlikedCalls())
+func (mock *ImageProducerMock) SendImageUnlikedCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Event *dto.ImageUnlikedEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageUnlikedEvent
+	}
+	mock.lockSendImageUnliked.RLock()
+	calls = mock.calls.SendImageUnliked
+	mock.lockSendImageUnliked.RUnlock()
+	return calls
+}
+
+// SendImageUpdated calls SendImageUpdatedFunc.
+func (mock *ImageProducerMock) SendImageUpdated(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+	if mock.SendImageUpdatedFunc == nil {
+		panic("ImageProducerMock.SendImageUpdatedFunc: method is nil but ImageProducer.SendImageUpdated was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageUpdatedEvent
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Event: event,
+	}
+	mock.lockSendImageUpdated.Lock()
+	mock.calls.SendImageUpdated = append(mock.calls.SendImageUpdated, callInfo)
+	mock.lockSendImageUpdated.Unlock()
+	return mock.SendImageUpdatedFunc(ctx, db, event)
+}
+
+// SendImageUpdatedCalls gets all the calls that were made to SendImageUpdated.
+// Check the length with:
+//
+//	len(mockedImageProducer.SendImageUpdatedCalls())
+func (mock *ImageProducerMock) SendImageUpdatedCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Event *dto.ImageUpdatedEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.ImageUpdatedEvent
+	}
+	mock.lockSendImageUpdated.RLock()
+	calls = mock.calls.SendImageUpdated
+	mock.lockSendImageUpdated.RUnlock()
+	return calls
+}
+
 // SendImageUploaded calls SendImageUploadedFunc.
 func (mock *ImageProducerMock) SendImageUploaded(ctx context.Context, db *gorm.DB, event *dto.ImageUploadedEvent) error {
 	if mock.SendImageUploadedFunc == nil {
@@ -24,6 +24,9 @@ var _ messaging.UserProducer = &UserProducerMock{}
 //			SendUserFollowedFunc: func(ctx context.Context, db *gorm.DB, event *dto.UserFollowedEvent) error {
 //				panic("mock out the SendUserFollowed method")
 //			},
+//			SendUserUnfollowedFunc: func(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+//				panic("mock out the SendUserUnfollowed method")
+//			},
 //		}
 //
 //		// use mockedUserProducer in code that requires messaging.UserProducer
@@ -34,6 +37,9 @@ type UserProducerMock struct {
 	// SendUserFollowedFunc mocks the SendUserFollowed method.
 	SendUserFollowedFunc func(ctx context.Context, db *gorm.DB, event *dto.UserFollowedEvent) error
 
+	// SendUserUnfollowedFunc mocks the SendUserUnfollowed method.
+	SendUserUnfollowedFunc func(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// SendUserFollowed holds details about calls to the SendUserFollowed method.
@@ -45,8 +51,18 @@ type UserProducerMock struct {
 			// Event is the event argument value.
 			Event *dto.UserFollowedEvent
 		}
+		// SendUserUnfollowed holds details about calls to the SendUserUnfollowed method.
+		SendUserUnfollowed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Event is the event argument value.
+			Event *dto.UserUnfollowedEvent
+		}
 	}
-	lockSendUserFollowed sync.RWMutex
+	lockSendUserFollowed   sync.RWMutex
+	lockSendUserUnfollowed sync.RWMutex
 }
 
 // SendUserFollowed calls SendUserFollowedFunc.
@@ -88,3 +104,43 @@ func (mock *UserProducerMock) SendUserFollowedCalls() []struct {
 	mock.lockSendUserFollowed.RUnlock()
 	return calls
 }
+
+// SendUserUnfollowed calls SendUserUnfollowedFunc.
+func (mock *UserProducerMock) SendUserUnfollowed(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+	if mock.SendUserUnfollowedFunc == nil {
+		panic("UserProducerMock.SendUserUnfollowedFunc: method is nil but UserProducer.SendUserUnfollowed was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.UserUnfollowedEvent
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Event: event,
+	}
+	mock.lockSendUserUnfollowed.Lock()
+	mock.calls.SendUserUnfollowed = append(mock.calls.SendUserUnfollowed, callInfo)
+	mock.lockSendUserUnfollowed.Unlock()
+	return mock.SendUserUnfollowedFunc(ctx, db, event)
+}
+
+// SendUserUnfollowedCalls gets all the calls that were made to SendUserUnfollowed.
+// Check the length with:
+//
+//	len(mockedUserProducer.SendUserUnfollowedCalls())
+func (mock *UserProducerMock) SendUserUnfollowedCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Event *dto.UserUnfollowedEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Event *dto.UserUnfollowedEvent
+	}
+	mock.lockSendUserUnfollowed.RLock()
+	calls = mock.calls.SendUserUnfollowed
+	mock.lockSendUserUnfollowed.RUnlock()
+	return calls
+}
@@ -24,9 +24,21 @@ var _ repository.CommentRepository = &CommentRepositoryMock{}
 //			CreateFunc: func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
 //				panic("mock out the Create method")
 //			},
+//			DeleteFunc: func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteByImageIDFunc: func(ctx context.Context, db *gorm.DB, imageID int64) error {
+//				panic("mock out the DeleteByImageID method")
+//			},
+//			FindByIDFunc: func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+//				panic("mock out the FindByID method")
+//			},
 //			FindByImageIDFunc: func(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error {
 //				panic("mock out the FindByImageID method")
 //			},
+//			UpdateFunc: func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+//				panic("mock out the Update method")
+//			},
 //		}
 //
 //		// use mockedCommentRepository in code that requires repository.CommentRepository
@@ -37,9 +49,21 @@ type CommentRepositoryMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
 
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
+
+	// DeleteByImageIDFunc mocks the DeleteByImageID method.
+	DeleteByImageIDFunc func(ctx context.Context, db *gorm.DB, imageID int64) error
+
+	// FindByIDFunc mocks the FindByID method.
+	FindByIDFunc func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error
+
 	// FindByImageIDFunc mocks the FindByImageID method.
 	FindByImageIDFunc func(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error
 
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -51,6 +75,35 @@ type CommentRepositoryMock struct {
 			// Comment is the comment argument value.
 			Comment *entity.Comment
 		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Comment is the comment argument value.
+			Comment *entity.Comment
+		}
+		// DeleteByImageID holds details about calls to the DeleteByImageID method.
+		DeleteByImageID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// ImageID is the imageID argument value.
+			ImageID int64
+		}
+		// FindByID holds details about calls to the FindByID method.
+		FindByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Comment is the comment argument value.
+			Comment *entity.Comment
+			// ID is the id argument value.
+			ID int64
+		}
 		// FindByImageID holds details about calls to the FindByImageID method.
 		FindByImageID []struct {
 			// Ctx is the ctx argument value.
@@ -62,9 +115,22 @@ type CommentRepositoryMock struct {
 			// ImageID is the imageID argument value.
 			ImageID int64
 		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Comment is the comment argument value.
+			Comment *entity.Comment
+		}
 	}
-	lockCreate        sync.RWMutex
-	lockFindByImageID sync.RWMutex
+	lockCreate          sync.RWMutex
+	lockDelete          sync.RWMutex
+	lockDeleteByImageID sync.RWMutex
+	lockFindByID        sync.RWMutex
+	lockFindByImageID   sync.RWMutex
+	lockUpdate          sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -107,6 +173,130 @@ func (mock *CommentRepositoryMock) CreateCalls() []struct {
 	return calls
 }
 
+// Delete calls DeleteFunc.
+func (mock *CommentRepositoryMock) Delete(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	if mock.DeleteFunc == nil {
+		panic("CommentRepositoryMock.DeleteFunc: method is nil but CommentRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		Comment: comment,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, db, comment)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedCommentRepository.DeleteCalls())
+func (mock *CommentRepositoryMock) DeleteCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	Comment *entity.Comment
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// DeleteByImageID calls DeleteByImageIDFunc.
+func (mock *CommentRepositoryMock) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	if mock.DeleteByImageIDFunc == nil {
+		panic("CommentRepositoryMock.DeleteByImageIDFunc: method is nil but CommentRepository.DeleteByImageID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		ImageID int64
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		ImageID: imageID,
+	}
+	mock.lockDeleteByImageID.Lock()
+	mock.calls.DeleteByImageID = append(mock.calls.DeleteByImageID, callInfo)
+	mock.lockDeleteByImageID.Unlock()
+	return mock.DeleteByImageIDFunc(ctx, db, imageID)
+}
+
+// DeleteByImageIDCalls gets all the calls that were made to DeleteByImageID.
+// Check the length with:
+//
+//	len(mockedCommentRepository.DeleteByImageIDCalls())
+func (mock *CommentRepositoryMock) DeleteByImageIDCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	ImageID int64
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		ImageID int64
+	}
+	mock.lockDeleteByImageID.RLock()
+	calls = mock.calls.DeleteByImageID
+	mock.lockDeleteByImageID.RUnlock()
+	return calls
+}
+
+// FindByID calls FindByIDFunc.
+func (mock *CommentRepositoryMock) FindByID(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+	if mock.FindByIDFunc == nil {
+		panic("CommentRepositoryMock.FindByIDFunc: method is nil but CommentRepository.FindByID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+		ID      int64
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		Comment: comment,
+		ID:      id,
+	}
+	mock.lockFindByID.Lock()
+	mock.calls.FindByID = append(mock.calls.FindByID, callInfo)
+	mock.lockFindByID.Unlock()
+	return mock.FindByIDFunc(ctx, db, comment, id)
+}
+
+// FindByIDCalls gets all the calls that were made to FindByID.
+// Check the length with:
+//
+//	len(mockedCommentRepository.FindByIDCalls())
+func (mock *CommentRepositoryMock) FindByIDCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	Comment *entity.Comment
+	ID      int64
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+		ID      int64
+	}
+	mock.lockFindByID.RLock()
+	calls = mock.calls.FindByID
+	mock.lockFindByID.RUnlock()
+	return calls
+}
+
 // FindByImageID calls FindByImageIDFunc.
 func (mock *CommentRepositoryMock) FindByImageID(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error {
 	if mock.FindByImageIDFunc == nil {
@@ -150,3 +340,43 @@ func (mock *CommentRepositoryMock) FindByImageIDCalls() []struct {
 	mock.lockFindByImageID.RUnlock()
 	return calls
 }
+
+// Update calls UpdateFunc.
+func (mock *CommentRepositoryMock) Update(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	if mock.UpdateFunc == nil {
+		panic("CommentRepositoryMock.UpdateFunc: method is nil but CommentRepository.Update was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		Comment: comment,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, db, comment)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedCommentRepository.UpdateCalls())
+func (mock *CommentRepositoryMock) UpdateCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	Comment *entity.Comment
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Comment *entity.Comment
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
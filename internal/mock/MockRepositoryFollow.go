@@ -24,6 +24,12 @@ var _ repository.FollowRepository = &FollowRepositoryMock{}
 //			CreateFunc: func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
 //				panic("mock out the Create method")
 //			},
+//			DeleteFunc: func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+//				panic("mock out the Delete method")
+//			},
+//			FindByFollowerIDAndFollowingIDFunc: func(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+//				panic("mock out the FindByFollowerIDAndFollowingID method")
+//			},
 //			FindByFollowingIDFunc: func(ctx context.Context, db *gorm.DB, followList *entity.FollowList, followingID int64) error {
 //				panic("mock out the FindByFollowingID method")
 //			},
@@ -37,6 +43,12 @@ type FollowRepositoryMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error
 
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error
+
+	// FindByFollowerIDAndFollowingIDFunc mocks the FindByFollowerIDAndFollowingID method.
+	FindByFollowerIDAndFollowingIDFunc func(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error
+
 	// FindByFollowingIDFunc mocks the FindByFollowingID method.
 	FindByFollowingIDFunc func(ctx context.Context, db *gorm.DB, followList *entity.FollowList, followingID int64) error
 
@@ -51,6 +63,28 @@ type FollowRepositoryMock struct {
 			// Follow is the follow argument value.
 			Follow *entity.Follow
 		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Follow is the follow argument value.
+			Follow *entity.Follow
+		}
+		// FindByFollowerIDAndFollowingID holds details about calls to the FindByFollowerIDAndFollowingID method.
+		FindByFollowerIDAndFollowingID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Follow is the follow argument value.
+			Follow *entity.Follow
+			// FollowerID is the followerID argument value.
+			FollowerID int64
+			// FollowingID is the followingID argument value.
+			FollowingID int64
+		}
 		// FindByFollowingID holds details about calls to the FindByFollowingID method.
 		FindByFollowingID []struct {
 			// Ctx is the ctx argument value.
@@ -63,8 +97,10 @@ type FollowRepositoryMock struct {
 			FollowingID int64
 		}
 	}
-	lockCreate            sync.RWMutex
-	lockFindByFollowingID sync.RWMutex
+	lockCreate                         sync.RWMutex
+	lockDelete                         sync.RWMutex
+	lockFindByFollowerIDAndFollowingID sync.RWMutex
+	lockFindByFollowingID              sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -107,6 +143,94 @@ func (mock *FollowRepositoryMock) CreateCalls() []struct {
 	return calls
 }
 
+// Delete calls DeleteFunc.
+func (mock *FollowRepositoryMock) Delete(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+	if mock.DeleteFunc == nil {
+		panic("FollowRepositoryMock.DeleteFunc: method is nil but FollowRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Db     *gorm.DB
+		Follow *entity.Follow
+	}{
+		Ctx:    ctx,
+		Db:     db,
+		Follow: follow,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, db, follow)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedFollowRepository.DeleteCalls())
+func (mock *FollowRepositoryMock) DeleteCalls() []struct {
+	Ctx    context.Context
+	Db     *gorm.DB
+	Follow *entity.Follow
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Db     *gorm.DB
+		Follow *entity.Follow
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// FindByFollowerIDAndFollowingID calls FindByFollowerIDAndFollowingIDFunc.
+func (mock *FollowRepositoryMock) FindByFollowerIDAndFollowingID(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+	if mock.FindByFollowerIDAndFollowingIDFunc == nil {
+		panic("FollowRepositoryMock.FindByFollowerIDAndFollowingIDFunc: method is nil but FollowRepository.FindByFollowerIDAndFollowingID was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Db          *gorm.DB
+		Follow      *entity.Follow
+		FollowerID  int64
+		FollowingID int64
+	}{
+		Ctx:         ctx,
+		Db:          db,
+		Follow:      follow,
+		FollowerID:  followerID,
+		FollowingID: followingID,
+	}
+	mock.lockFindByFollowerIDAndFollowingID.Lock()
+	mock.calls.FindByFollowerIDAndFollowingID = append(mock.calls.FindByFollowerIDAndFollowingID, callInfo)
+	mock.lockFindByFollowerIDAndFollowingID.Unlock()
+	return mock.FindByFollowerIDAndFollowingIDFunc(ctx, db, follow, followerID, followingID)
+}
+
+// FindByFollowerIDAndFollowingIDCalls gets all the calls that were made to FindByFollowerIDAndFollowingID.
+// Check the length with:
+//
+//	len(mockedFollowRepository.FindByFollowerIDAndFollowingIDCalls())
+func (mock *FollowRepositoryMock) FindByFollowerIDAndFollowingIDCalls() []struct {
+	Ctx         context.Context
+	Db          *gorm.DB
+	Follow      *entity.Follow
+	FollowerID  int64
+	FollowingID int64
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Db          *gorm.DB
+		Follow      *entity.Follow
+		FollowerID  int64
+		FollowingID int64
+	}
+	mock.lockFindByFollowerIDAndFollowingID.RLock()
+	calls = mock.calls.FindByFollowerIDAndFollowingID
+	mock.lockFindByFollowerIDAndFollowingID.RUnlock()
+	return calls
+}
+
 // FindByFollowingID calls FindByFollowingIDFunc.
 func (mock *FollowRepositoryMock) FindByFollowingID(ctx context.Context, db *gorm.DB, followList *entity.FollowList, followingID int64) error {
 	if mock.FindByFollowingIDFunc == nil {
@@ -21,10 +21,13 @@ var _ repository.IdempotencyRepository = &IdempotencyRepositoryMock{}
 //
 //		// make and configure a mocked repository.IdempotencyRepository
 //		mockedIdempotencyRepository := &IdempotencyRepositoryMock{
+//			DeleteFunc: func(ctx context.Context, db *gorm.DB, consumerGroup string, key string) error {
+//				panic("mock out the Delete method")
+//			},
 //			DeleteOlderThanFunc: func(ctx context.Context, db *gorm.DB, age time.Duration) (int64, error) {
 //				panic("mock out the DeleteOlderThan method")
 //			},
-//			InsertIfNotExistsFunc: func(ctx context.Context, db *gorm.DB, key string, topic string, partition int32, offset int64) (bool, error) {
+//			InsertIfNotExistsFunc: func(ctx context.Context, db *gorm.DB, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error) {
 //				panic("mock out the InsertIfNotExists method")
 //			},
 //		}
@@ -34,14 +37,28 @@ var _ repository.IdempotencyRepository = &IdempotencyRepositoryMock{}
 //
 //	}
 type IdempotencyRepositoryMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, db *gorm.DB, consumerGroup string, key string) error
+
 	// DeleteOlderThanFunc mocks the DeleteOlderThan method.
 	DeleteOlderThanFunc func(ctx context.Context, db *gorm.DB, age time.Duration) (int64, error)
 
 	// InsertIfNotExistsFunc mocks the InsertIfNotExists method.
-	InsertIfNotExistsFunc func(ctx context.Context, db *gorm.DB, key string, topic string, partition int32, offset int64) (bool, error)
+	InsertIfNotExistsFunc func(ctx context.Context, db *gorm.DB, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// ConsumerGroup is the consumerGroup argument value.
+			ConsumerGroup string
+			// Key is the key argument value.
+			Key string
+		}
 		// DeleteOlderThan holds details about calls to the DeleteOlderThan method.
 		DeleteOlderThan []struct {
 			// Ctx is the ctx argument value.
@@ -57,6 +74,8 @@ type IdempotencyRepositoryMock struct {
 			Ctx context.Context
 			// Db is the db argument value.
 			Db *gorm.DB
+			// ConsumerGroup is the consumerGroup argument value.
+			ConsumerGroup string
 			// Key is the key argument value.
 			Key string
 			// Topic is the topic argument value.
@@ -67,10 +86,55 @@ type IdempotencyRepositoryMock struct {
 			Offset int64
 		}
 	}
+	lockDelete            sync.RWMutex
 	lockDeleteOlderThan   sync.RWMutex
 	lockInsertIfNotExists sync.RWMutex
 }
 
+// Delete calls DeleteFunc.
+func (mock *IdempotencyRepositoryMock) Delete(ctx context.Context, db *gorm.DB, consumerGroup string, key string) error {
+	if mock.DeleteFunc == nil {
+		panic("IdempotencyRepositoryMock.DeleteFunc: method is nil but IdempotencyRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Db            *gorm.DB
+		ConsumerGroup string
+		Key           string
+	}{
+		Ctx:           ctx,
+		Db:            db,
+		ConsumerGroup: consumerGroup,
+		Key:           key,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, db, consumerGroup, key)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedIdempotencyRepository.DeleteCalls())
+func (mock *IdempotencyRepositoryMock) DeleteCalls() []struct {
+	Ctx           context.Context
+	Db            *gorm.DB
+	ConsumerGroup string
+	Key           string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Db            *gorm.DB
+		ConsumerGroup string
+		Key           string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
 // DeleteOlderThan calls DeleteOlderThanFunc.
 func (mock *IdempotencyRepositoryMock) DeleteOlderThan(ctx context.Context, db *gorm.DB, age time.Duration) (int64, error) {
 	if mock.DeleteOlderThanFunc == nil {
@@ -112,29 +176,31 @@ func (mock *IdempotencyRepositoryMock) DeleteOlderThanCalls() []struct {
 }
 
 // InsertIfNotExists calls InsertIfNotExistsFunc.
-func (mock *IdempotencyRepositoryMock) InsertIfNotExists(ctx context.Context, db *gorm.DB, key string, topic string, partition int32, offset int64) (bool, error) {
+func (mock *IdempotencyRepositoryMock) InsertIfNotExists(ctx context.Context, db *gorm.DB, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error) {
 	if mock.InsertIfNotExistsFunc == nil {
 		panic("IdempotencyRepositoryMock.InsertIfNotExistsFunc: method is nil but IdempotencyRepository.InsertIfNotExists was just called")
 	}
 	callInfo := struct {
-		Ctx       context.Context
-		Db        *gorm.DB
-		Key       string
-		Topic     string
-		Partition int32
-		Offset    int64
+		Ctx           context.Context
+		Db            *gorm.DB
+		ConsumerGroup string
+		Key           string
+		Topic         string
+		Partition     int32
+		Offset        int64
 	}{
-		Ctx:       ctx,
-		Db:        db,
-		Key:       key,
-		Topic:     topic,
-		Partition: partition,
-		Offset:    offset,
+		Ctx:           ctx,
+		Db:            db,
+		ConsumerGroup: consumerGroup,
+		Key:           key,
+		Topic:         topic,
+		Partition:     partition,
+		Offset:        offset,
 	}
 	mock.lockInsertIfNotExists.Lock()
 	mock.calls.InsertIfNotExists = append(mock.calls.InsertIfNotExists, callInfo)
 	mock.lockInsertIfNotExists.Unlock()
-	return mock.InsertIfNotExistsFunc(ctx, db, key, topic, partition, offset)
+	return mock.InsertIfNotExistsFunc(ctx, db, consumerGroup, key, topic, partition, offset)
 }
 
 // InsertIfNotExistsCalls gets all the calls that were made to InsertIfNotExists.
@@ -142,20 +208,22 @@ func (mock *IdempotencyRepositoryMock) InsertIfNotExists(ctx context.Context, db
 //
 //	len(mockedIdempotencyRepository.InsertIfNotExistsCalls())
 func (mock *IdempotencyRepositoryMock) InsertIfNotExistsCalls() []struct {
-	Ctx       context.Context
-	Db        *gorm.DB
-	Key       string
-	Topic     string
-	Partition int32
-	Offset    int64
+	Ctx           context.Context
+	Db            *gorm.DB
+	ConsumerGroup string
+	Key           string
+	Topic         string
+	Partition     int32
+	Offset        int64
 } {
 	var calls []struct {
-		Ctx       context.Context
-		Db        *gorm.DB
-		Key       string
-		Topic     string
-		Partition int32
-		Offset    int64
+		Ctx           context.Context
+		Db            *gorm.DB
+		ConsumerGroup string
+		Key           string
+		Topic         string
+		Partition     int32
+		Offset        int64
 	}
 	mock.lockInsertIfNotExists.RLock()
 	calls = mock.calls.InsertIfNotExists
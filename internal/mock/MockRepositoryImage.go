@@ -24,6 +24,9 @@ var _ repository.ImageRepository = &ImageRepositoryMock{}
 //			CreateFunc: func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
 //				panic("mock out the Create method")
 //			},
+//			DeleteFunc: func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+//				panic("mock out the Delete method")
+//			},
 //			FindByIDFunc: func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
 //				panic("mock out the FindByID method")
 //			},
@@ -33,6 +36,9 @@ var _ repository.ImageRepository = &ImageRepositoryMock{}
 //			IncrementLikeCountByIDFunc: func(ctx context.Context, db *gorm.DB, id int64, count int) error {
 //				panic("mock out the IncrementLikeCountByID method")
 //			},
+//			UpdateCaptionFunc: func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+//				panic("mock out the UpdateCaption method")
+//			},
 //		}
 //
 //		// use mockedImageRepository in code that requires repository.ImageRepository
@@ -43,6 +49,9 @@ type ImageRepositoryMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, db *gorm.DB, image *entity.Image) error
 
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, db *gorm.DB, image *entity.Image) error
+
 	// FindByIDFunc mocks the FindByID method.
 	FindByIDFunc func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error
 
@@ -52,6 +61,9 @@ type ImageRepositoryMock struct {
 	// IncrementLikeCountByIDFunc mocks the IncrementLikeCountByID method.
 	IncrementLikeCountByIDFunc func(ctx context.Context, db *gorm.DB, id int64, count int) error
 
+	// UpdateCaptionFunc mocks the UpdateCaption method.
+	UpdateCaptionFunc func(ctx context.Context, db *gorm.DB, image *entity.Image) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -63,6 +75,15 @@ type ImageRepositoryMock struct {
 			// Image is the image argument value.
 			Image *entity.Image
 		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Image is the image argument value.
+			Image *entity.Image
+		}
 		// FindByID holds details about calls to the FindByID method.
 		FindByID []struct {
 			// Ctx is the ctx argument value.
@@ -96,11 +117,22 @@ type ImageRepositoryMock struct {
 			// Count is the count argument value.
 			Count int
 		}
+		// UpdateCaption holds details about calls to the UpdateCaption method.
+		UpdateCaption []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Image is the image argument value.
+			Image *entity.Image
+		}
 	}
 	lockCreate                    sync.RWMutex
+	lockDelete                    sync.RWMutex
 	lockFindByID                  sync.RWMutex
 	lockIncrementCommentCountByID sync.RWMutex
 	lockIncrementLikeCountByID    sync.RWMutex
+	lockUpdateCaption             sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -143,6 +175,46 @@ func (mock *ImageRepositoryMock) CreateCalls() []struct {
 	return calls
 }
 
+// Delete calls DeleteFunc.
+func (mock *ImageRepositoryMock) Delete(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	if mock.DeleteFunc == nil {
+		panic("ImageRepositoryMock.DeleteFunc: method is nil but ImageRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Image *entity.Image
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Image: image,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, db, image)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedImageRepository.DeleteCalls())
+func (mock *ImageRepositoryMock) DeleteCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Image *entity.Image
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Image *entity.Image
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
 // FindByID calls FindByIDFunc.
 func (mock *ImageRepositoryMock) FindByID(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
 	if mock.FindByIDFunc == nil {
@@ -274,3 +346,43 @@ func (mock *ImageRepositoryMock) IncrementLikeCountByIDCalls() []struct {
 	mock.lockIncrementLikeCountByID.RUnlock()
 	return calls
 }
+
+// UpdateCaption calls UpdateCaptionFunc.
+func (mock *ImageRepositoryMock) UpdateCaption(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	if mock.UpdateCaptionFunc == nil {
+		panic("ImageRepositoryMock.UpdateCaptionFunc: method is nil but ImageRepository.UpdateCaption was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Image *entity.Image
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		Image: image,
+	}
+	mock.lockUpdateCaption.Lock()
+	mock.calls.UpdateCaption = append(mock.calls.UpdateCaption, callInfo)
+	mock.lockUpdateCaption.Unlock()
+	return mock.UpdateCaptionFunc(ctx, db, image)
+}
+
+// UpdateCaptionCalls gets all the calls that were made to UpdateCaption.
+// Check the length with:
+//
+//	len(mockedImageRepository.UpdateCaptionCalls())
+func (mock *ImageRepositoryMock) UpdateCaptionCalls() []struct {
+	Ctx   context.Context
+	Db    *gorm.DB
+	Image *entity.Image
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    *gorm.DB
+		Image *entity.Image
+	}
+	mock.lockUpdateCaption.RLock()
+	calls = mock.calls.UpdateCaption
+	mock.lockUpdateCaption.RUnlock()
+	return calls
+}
@@ -24,9 +24,18 @@ var _ repository.LikeRepository = &LikeRepositoryMock{}
 //			CreateFunc: func(ctx context.Context, db *gorm.DB, like *entity.Like) error {
 //				panic("mock out the Create method")
 //			},
+//			DeleteFunc: func(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteByImageIDFunc: func(ctx context.Context, db *gorm.DB, imageID int64) error {
+//				panic("mock out the DeleteByImageID method")
+//			},
 //			FindByImageIDFunc: func(ctx context.Context, db *gorm.DB, likeList *entity.LikeList, imageID int64) error {
 //				panic("mock out the FindByImageID method")
 //			},
+//			FindByUserIDAndImageIDFunc: func(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+//				panic("mock out the FindByUserIDAndImageID method")
+//			},
 //		}
 //
 //		// use mockedLikeRepository in code that requires repository.LikeRepository
@@ -37,9 +46,18 @@ type LikeRepositoryMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, db *gorm.DB, like *entity.Like) error
 
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, db *gorm.DB, like *entity.Like) error
+
+	// DeleteByImageIDFunc mocks the DeleteByImageID method.
+	DeleteByImageIDFunc func(ctx context.Context, db *gorm.DB, imageID int64) error
+
 	// FindByImageIDFunc mocks the FindByImageID method.
 	FindByImageIDFunc func(ctx context.Context, db *gorm.DB, likeList *entity.LikeList, imageID int64) error
 
+	// FindByUserIDAndImageIDFunc mocks the FindByUserIDAndImageID method.
+	FindByUserIDAndImageIDFunc func(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -51,6 +69,24 @@ type LikeRepositoryMock struct {
 			// Like is the like argument value.
 			Like *entity.Like
 		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Like is the like argument value.
+			Like *entity.Like
+		}
+		// DeleteByImageID holds details about calls to the DeleteByImageID method.
+		DeleteByImageID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// ImageID is the imageID argument value.
+			ImageID int64
+		}
 		// FindByImageID holds details about calls to the FindByImageID method.
 		FindByImageID []struct {
 			// Ctx is the ctx argument value.
@@ -62,9 +98,25 @@ type LikeRepositoryMock struct {
 			// ImageID is the imageID argument value.
 			ImageID int64
 		}
+		// FindByUserIDAndImageID holds details about calls to the FindByUserIDAndImageID method.
+		FindByUserIDAndImageID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Like is the like argument value.
+			Like *entity.Like
+			// UserID is the userID argument value.
+			UserID int64
+			// ImageID is the imageID argument value.
+			ImageID int64
+		}
 	}
-	lockCreate        sync.RWMutex
-	lockFindByImageID sync.RWMutex
+	lockCreate                 sync.RWMutex
+	lockDelete                 sync.RWMutex
+	lockDeleteByImageID        sync.RWMutex
+	lockFindByImageID          sync.RWMutex
+	lockFindByUserIDAndImageID sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -107,6 +159,86 @@ func (mock *LikeRepositoryMock) CreateCalls() []struct {
 	return calls
 }
 
+// Delete calls DeleteFunc.
+func (mock *LikeRepositoryMock) Delete(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+	if mock.DeleteFunc == nil {
+		panic("LikeRepositoryMock.DeleteFunc: method is nil but LikeRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   *gorm.DB
+		Like *entity.Like
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		Like: like,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, db, like)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedLikeRepository.DeleteCalls())
+func (mock *LikeRepositoryMock) DeleteCalls() []struct {
+	Ctx  context.Context
+	Db   *gorm.DB
+	Like *entity.Like
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   *gorm.DB
+		Like *entity.Like
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// DeleteByImageID calls DeleteByImageIDFunc.
+func (mock *LikeRepositoryMock) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	if mock.DeleteByImageIDFunc == nil {
+		panic("LikeRepositoryMock.DeleteByImageIDFunc: method is nil but LikeRepository.DeleteByImageID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		ImageID int64
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		ImageID: imageID,
+	}
+	mock.lockDeleteByImageID.Lock()
+	mock.calls.DeleteByImageID = append(mock.calls.DeleteByImageID, callInfo)
+	mock.lockDeleteByImageID.Unlock()
+	return mock.DeleteByImageIDFunc(ctx, db, imageID)
+}
+
+// DeleteByImageIDCalls gets all the calls that were made to DeleteByImageID.
+// Check the length with:
+//
+//	len(mockedLikeRepository.DeleteByImageIDCalls())
+func (mock *LikeRepositoryMock) DeleteByImageIDCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	ImageID int64
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		ImageID int64
+	}
+	mock.lockDeleteByImageID.RLock()
+	calls = mock.calls.DeleteByImageID
+	mock.lockDeleteByImageID.RUnlock()
+	return calls
+}
+
 // FindByImageID calls FindByImageIDFunc.
 func (mock *LikeRepositoryMock) FindByImageID(ctx context.Context, db *gorm.DB, likeList *entity.LikeList, imageID int64) error {
 	if mock.FindByImageIDFunc == nil {
@@ -150,3 +282,51 @@ func (mock *LikeRepositoryMock) FindByImageIDCalls() []struct {
 	mock.lockFindByImageID.RUnlock()
 	return calls
 }
+
+// FindByUserIDAndImageID calls FindByUserIDAndImageIDFunc.
+func (mock *LikeRepositoryMock) FindByUserIDAndImageID(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+	if mock.FindByUserIDAndImageIDFunc == nil {
+		panic("LikeRepositoryMock.FindByUserIDAndImageIDFunc: method is nil but LikeRepository.FindByUserIDAndImageID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Like    *entity.Like
+		UserID  int64
+		ImageID int64
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		Like:    like,
+		UserID:  userID,
+		ImageID: imageID,
+	}
+	mock.lockFindByUserIDAndImageID.Lock()
+	mock.calls.FindByUserIDAndImageID = append(mock.calls.FindByUserIDAndImageID, callInfo)
+	mock.lockFindByUserIDAndImageID.Unlock()
+	return mock.FindByUserIDAndImageIDFunc(ctx, db, like, userID, imageID)
+}
+
+// FindByUserIDAndImageIDCalls gets all the calls that were made to FindByUserIDAndImageID.
+// Check the length with:
+//
+//	len(mockedLikeRepository.FindByUserIDAndImageIDCalls())
+func (mock *LikeRepositoryMock) FindByUserIDAndImageIDCalls() []struct {
+	Ctx     context.Context
+	Db      *gorm.DB
+	Like    *entity.Like
+	UserID  int64
+	ImageID int64
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      *gorm.DB
+		Like    *entity.Like
+		UserID  int64
+		ImageID int64
+	}
+	mock.lockFindByUserIDAndImageID.RLock()
+	calls = mock.calls.FindByUserIDAndImageID
+	mock.lockFindByUserIDAndImageID.RUnlock()
+	return calls
+}
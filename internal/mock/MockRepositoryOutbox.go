@@ -9,6 +9,7 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
 	"gorm.io/gorm"
 	"sync"
+	"time"
 )
 
 // Ensure, that OutboxRepositoryMock does implement repository.OutboxRepository.
@@ -21,15 +22,30 @@ var _ repository.OutboxRepository = &OutboxRepositoryMock{}
 //
 //		// make and configure a mocked repository.OutboxRepository
 //		mockedOutboxRepository := &OutboxRepositoryMock{
+//			DeleteByIDsFunc: func(ctx context.Context, db *gorm.DB, ids []int64) error {
+//				panic("mock out the DeleteByIDs method")
+//			},
+//			FindOlderThanFunc: func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+//				panic("mock out the FindOlderThan method")
+//			},
 //			FindPendingFunc: func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, limit int) error {
 //				panic("mock out the FindPending method")
 //			},
 //			InsertFunc: func(ctx context.Context, db *gorm.DB, outbox *entity.Outbox) error {
 //				panic("mock out the Insert method")
 //			},
+//			InsertArchiveFunc: func(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+//				panic("mock out the InsertArchive method")
+//			},
+//			MarkFailedFunc: func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+//				panic("mock out the MarkFailed method")
+//			},
 //			MarkProducedFunc: func(ctx context.Context, db *gorm.DB, ids []int64) error {
 //				panic("mock out the MarkProduced method")
 //			},
+//			MarkRetryFunc: func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+//				panic("mock out the MarkRetry method")
+//			},
 //		}
 //
 //		// use mockedOutboxRepository in code that requires repository.OutboxRepository
@@ -37,17 +53,56 @@ var _ repository.OutboxRepository = &OutboxRepositoryMock{}
 //
 //	}
 type OutboxRepositoryMock struct {
+	// DeleteByIDsFunc mocks the DeleteByIDs method.
+	DeleteByIDsFunc func(ctx context.Context, db *gorm.DB, ids []int64) error
+
+	// FindOlderThanFunc mocks the FindOlderThan method.
+	FindOlderThanFunc func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error
+
 	// FindPendingFunc mocks the FindPending method.
 	FindPendingFunc func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, limit int) error
 
 	// InsertFunc mocks the Insert method.
 	InsertFunc func(ctx context.Context, db *gorm.DB, outbox *entity.Outbox) error
 
+	// InsertArchiveFunc mocks the InsertArchive method.
+	InsertArchiveFunc func(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error
+
+	// MarkFailedFunc mocks the MarkFailed method.
+	MarkFailedFunc func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error
+
 	// MarkProducedFunc mocks the MarkProduced method.
 	MarkProducedFunc func(ctx context.Context, db *gorm.DB, ids []int64) error
 
+	// MarkRetryFunc mocks the MarkRetry method.
+	MarkRetryFunc func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// DeleteByIDs holds details about calls to the DeleteByIDs method.
+		DeleteByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Ids is the ids argument value.
+			Ids []int64
+		}
+		// FindOlderThan holds details about calls to the FindOlderThan method.
+		FindOlderThan []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Outboxes is the outboxes argument value.
+			Outboxes *entity.OutboxList
+			// Status is the status argument value.
+			Status string
+			// Age is the age argument value.
+			Age time.Duration
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// FindPending holds details about calls to the FindPending method.
 		FindPending []struct {
 			// Ctx is the ctx argument value.
@@ -68,6 +123,28 @@ type OutboxRepositoryMock struct {
 			// Outbox is the outbox argument value.
 			Outbox *entity.Outbox
 		}
+		// InsertArchive holds details about calls to the InsertArchive method.
+		InsertArchive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// Archives is the archives argument value.
+			Archives *entity.OutboxArchiveList
+		}
+		// MarkFailed holds details about calls to the MarkFailed method.
+		MarkFailed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// ID is the id argument value.
+			ID int64
+			// Attempts is the attempts argument value.
+			Attempts int
+			// LastError is the lastError argument value.
+			LastError string
+		}
 		// MarkProduced holds details about calls to the MarkProduced method.
 		MarkProduced []struct {
 			// Ctx is the ctx argument value.
@@ -77,10 +154,122 @@ type OutboxRepositoryMock struct {
 			// Ids is the ids argument value.
 			Ids []int64
 		}
+		// MarkRetry holds details about calls to the MarkRetry method.
+		MarkRetry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db *gorm.DB
+			// ID is the id argument value.
+			ID int64
+			// Attempts is the attempts argument value.
+			Attempts int
+			// LastError is the lastError argument value.
+			LastError string
+			// NextAttemptAt is the nextAttemptAt argument value.
+			NextAttemptAt time.Time
+		}
 	}
-	lockFindPending  sync.RWMutex
-	lockInsert       sync.RWMutex
-	lockMarkProduced sync.RWMutex
+	lockDeleteByIDs   sync.RWMutex
+	lockFindOlderThan sync.RWMutex
+	lockFindPending   sync.RWMutex
+	lockInsert        sync.RWMutex
+	lockInsertArchive sync.RWMutex
+	lockMarkFailed    sync.RWMutex
+	lockMarkProduced  sync.RWMutex
+	lockMarkRetry     sync.RWMutex
+}
+
+// DeleteByIDs calls DeleteByIDsFunc.
+func (mock *OutboxRepositoryMock) DeleteByIDs(ctx context.Context, db *gorm.DB, ids []int64) error {
+	if mock.DeleteByIDsFunc == nil {
+		panic("OutboxRepositoryMock.DeleteByIDsFunc: method is nil but OutboxRepository.DeleteByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  *gorm.DB
+		Ids []int64
+	}{
+		Ctx: ctx,
+		Db:  db,
+		Ids: ids,
+	}
+	mock.lockDeleteByIDs.Lock()
+	mock.calls.DeleteByIDs = append(mock.calls.DeleteByIDs, callInfo)
+	mock.lockDeleteByIDs.Unlock()
+	return mock.DeleteByIDsFunc(ctx, db, ids)
+}
+
+// DeleteByIDsCalls gets all the calls that were made to DeleteByIDs.
+// Check the length with:
+//
+//	len(mockedOutboxRepository.DeleteByIDsCalls())
+func (mock *OutboxRepositoryMock) DeleteByIDsCalls() []struct {
+	Ctx context.Context
+	Db  *gorm.DB
+	Ids []int64
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  *gorm.DB
+		Ids []int64
+	}
+	mock.lockDeleteByIDs.RLock()
+	calls = mock.calls.DeleteByIDs
+	mock.lockDeleteByIDs.RUnlock()
+	return calls
+}
+
+// FindOlderThan calls FindOlderThanFunc.
+func (mock *OutboxRepositoryMock) FindOlderThan(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+	if mock.FindOlderThanFunc == nil {
+		panic("OutboxRepositoryMock.FindOlderThanFunc: method is nil but OutboxRepository.FindOlderThan was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Db       *gorm.DB
+		Outboxes *entity.OutboxList
+		Status   string
+		Age      time.Duration
+		Limit    int
+	}{
+		Ctx:      ctx,
+		Db:       db,
+		Outboxes: outboxes,
+		Status:   status,
+		Age:      age,
+		Limit:    limit,
+	}
+	mock.lockFindOlderThan.Lock()
+	mock.calls.FindOlderThan = append(mock.calls.FindOlderThan, callInfo)
+	mock.lockFindOlderThan.Unlock()
+	return mock.FindOlderThanFunc(ctx, db, outboxes, status, age, limit)
+}
+
+// FindOlderThanCalls gets all the calls that were made to FindOlderThan.
+// Check the length with:
+//
+//	len(mockedOutboxRepository.FindOlderThanCalls())
+func (mock *OutboxRepositoryMock) FindOlderThanCalls() []struct {
+	Ctx      context.Context
+	Db       *gorm.DB
+	Outboxes *entity.OutboxList
+	Status   string
+	Age      time.Duration
+	Limit    int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Db       *gorm.DB
+		Outboxes *entity.OutboxList
+		Status   string
+		Age      time.Duration
+		Limit    int
+	}
+	mock.lockFindOlderThan.RLock()
+	calls = mock.calls.FindOlderThan
+	mock.lockFindOlderThan.RUnlock()
+	return calls
 }
 
 // FindPending calls FindPendingFunc.
@@ -167,6 +356,94 @@ func (mock *OutboxRepositoryMock) InsertCalls() []struct {
 	return calls
 }
 
+// InsertArchive calls InsertArchiveFunc.
+func (mock *OutboxRepositoryMock) InsertArchive(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+	if mock.InsertArchiveFunc == nil {
+		panic("OutboxRepositoryMock.InsertArchiveFunc: method is nil but OutboxRepository.InsertArchive was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Db       *gorm.DB
+		Archives *entity.OutboxArchiveList
+	}{
+		Ctx:      ctx,
+		Db:       db,
+		Archives: archives,
+	}
+	mock.lockInsertArchive.Lock()
+	mock.calls.InsertArchive = append(mock.calls.InsertArchive, callInfo)
+	mock.lockInsertArchive.Unlock()
+	return mock.InsertArchiveFunc(ctx, db, archives)
+}
+
+// InsertArchiveCalls gets all the calls that were made to InsertArchive.
+// Check the length with:
+//
+//	len(mockedOutboxRepository.InsertArchiveCalls())
+func (mock *OutboxRepositoryMock) InsertArchiveCalls() []struct {
+	Ctx      context.Context
+	Db       *gorm.DB
+	Archives *entity.OutboxArchiveList
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Db       *gorm.DB
+		Archives *entity.OutboxArchiveList
+	}
+	mock.lockInsertArchive.RLock()
+	calls = mock.calls.InsertArchive
+	mock.lockInsertArchive.RUnlock()
+	return calls
+}
+
+// MarkFailed calls MarkFailedFunc.
+func (mock *OutboxRepositoryMock) MarkFailed(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+	if mock.MarkFailedFunc == nil {
+		panic("OutboxRepositoryMock.MarkFailedFunc: method is nil but OutboxRepository.MarkFailed was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Db        *gorm.DB
+		ID        int64
+		Attempts  int
+		LastError string
+	}{
+		Ctx:       ctx,
+		Db:        db,
+		ID:        id,
+		Attempts:  attempts,
+		LastError: lastError,
+	}
+	mock.lockMarkFailed.Lock()
+	mock.calls.MarkFailed = append(mock.calls.MarkFailed, callInfo)
+	mock.lockMarkFailed.Unlock()
+	return mock.MarkFailedFunc(ctx, db, id, attempts, lastError)
+}
+
+// MarkFailedCalls gets all the calls that were made to MarkFailed.
+// Check the length with:
+//
+//	len(mockedOutboxRepository.MarkFailedCalls())
+func (mock *OutboxRepositoryMock) MarkFailedCalls() []struct {
+	Ctx       context.Context
+	Db        *gorm.DB
+	ID        int64
+	Attempts  int
+	LastError string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Db        *gorm.DB
+		ID        int64
+		Attempts  int
+		LastError string
+	}
+	mock.lockMarkFailed.RLock()
+	calls = mock.calls.MarkFailed
+	mock.lockMarkFailed.RUnlock()
+	return calls
+}
+
 // MarkProduced calls MarkProducedFunc.
 func (mock *OutboxRepositoryMock) MarkProduced(ctx context.Context, db *gorm.DB, ids []int64) error {
 	if mock.MarkProducedFunc == nil {
@@ -206,3 +483,55 @@ func (mock *OutboxRepositoryMock) MarkProducedCalls() []struct {
 	mock.lockMarkProduced.RUnlock()
 	return calls
 }
+
+// MarkRetry calls MarkRetryFunc.
+func (mock *OutboxRepositoryMock) MarkRetry(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+	if mock.MarkRetryFunc == nil {
+		panic("OutboxRepositoryMock.MarkRetryFunc: method is nil but OutboxRepository.MarkRetry was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Db            *gorm.DB
+		ID            int64
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+	}{
+		Ctx:           ctx,
+		Db:            db,
+		ID:            id,
+		Attempts:      attempts,
+		LastError:     lastError,
+		NextAttemptAt: nextAttemptAt,
+	}
+	mock.lockMarkRetry.Lock()
+	mock.calls.MarkRetry = append(mock.calls.MarkRetry, callInfo)
+	mock.lockMarkRetry.Unlock()
+	return mock.MarkRetryFunc(ctx, db, id, attempts, lastError, nextAttemptAt)
+}
+
+// MarkRetryCalls gets all the calls that were made to MarkRetry.
+// Check the length with:
+//
+//	len(mockedOutboxRepository.MarkRetryCalls())
+func (mock *OutboxRepositoryMock) MarkRetryCalls() []struct {
+	Ctx           context.Context
+	Db            *gorm.DB
+	ID            int64
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Db            *gorm.DB
+		ID            int64
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+	}
+	mock.lockMarkRetry.RLock()
+	calls = mock.calls.MarkRetry
+	mock.lockMarkRetry.RUnlock()
+	return calls
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/search"
 	"sync"
+	"time"
 )
 
 // Ensure, that ImageSearchMock does implement search.ImageSearch.
@@ -20,6 +21,12 @@ var _ search.ImageSearch = &ImageSearchMock{}
 //
 //		// make and configure a mocked search.ImageSearch
 //		mockedImageSearch := &ImageSearchMock{
+//			DeleteImageFunc: func(ctx context.Context, id int64, deletedAt time.Time) error {
+//				panic("mock out the DeleteImage method")
+//			},
+//			DeleteLegacyImagesFunc: func(ctx context.Context) (int, error) {
+//				panic("mock out the DeleteLegacyImages method")
+//			},
 //			IndexImageFunc: func(ctx context.Context, document *dto.ImageDocument) error {
 //				panic("mock out the IndexImage method")
 //			},
@@ -30,11 +37,31 @@ var _ search.ImageSearch = &ImageSearchMock{}
 //
 //	}
 type ImageSearchMock struct {
+	// DeleteImageFunc mocks the DeleteImage method.
+	DeleteImageFunc func(ctx context.Context, id int64, deletedAt time.Time) error
+
+	// DeleteLegacyImagesFunc mocks the DeleteLegacyImages method.
+	DeleteLegacyImagesFunc func(ctx context.Context) (int, error)
+
 	// IndexImageFunc mocks the IndexImage method.
 	IndexImageFunc func(ctx context.Context, document *dto.ImageDocument) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// DeleteImage holds details about calls to the DeleteImage method.
+		DeleteImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int64
+			// DeletedAt is the deletedAt argument value.
+			DeletedAt time.Time
+		}
+		// DeleteLegacyImages holds details about calls to the DeleteLegacyImages method.
+		DeleteLegacyImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// IndexImage holds details about calls to the IndexImage method.
 		IndexImage []struct {
 			// Ctx is the ctx argument value.
@@ -43,7 +70,81 @@ type ImageSearchMock struct {
 			Document *dto.ImageDocument
 		}
 	}
-	lockIndexImage sync.RWMutex
+	lockDeleteImage        sync.RWMutex
+	lockDeleteLegacyImages sync.RWMutex
+	lockIndexImage         sync.RWMutex
+}
+
+// DeleteImage calls DeleteImageFunc.
+func (mock *ImageSearchMock) DeleteImage(ctx context.Context, id int64, deletedAt time.Time) error {
+	if mock.DeleteImageFunc == nil {
+		panic("ImageSearchMock.DeleteImageFunc: method is nil but ImageSearch.DeleteImage was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ID        int64
+		DeletedAt time.Time
+	}{
+		Ctx:       ctx,
+		ID:        id,
+		DeletedAt: deletedAt,
+	}
+	mock.lockDeleteImage.Lock()
+	mock.calls.DeleteImage = append(mock.calls.DeleteImage, callInfo)
+	mock.lockDeleteImage.Unlock()
+	return mock.DeleteImageFunc(ctx, id, deletedAt)
+}
+
+// DeleteImageCalls gets all the calls that were made to DeleteImage.
+// Check the length with:
+//
+//	len(mockedImageSearch.DeleteImageCalls())
+func (mock *ImageSearchMock) DeleteImageCalls() []struct {
+	Ctx       context.Context
+	ID        int64
+	DeletedAt time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ID        int64
+		DeletedAt time.Time
+	}
+	mock.lockDeleteImage.RLock()
+	calls = mock.calls.DeleteImage
+	mock.lockDeleteImage.RUnlock()
+	return calls
+}
+
+// DeleteLegacyImages calls DeleteLegacyImagesFunc.
+func (mock *ImageSearchMock) DeleteLegacyImages(ctx context.Context) (int, error) {
+	if mock.DeleteLegacyImagesFunc == nil {
+		panic("ImageSearchMock.DeleteLegacyImagesFunc: method is nil but ImageSearch.DeleteLegacyImages was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteLegacyImages.Lock()
+	mock.calls.DeleteLegacyImages = append(mock.calls.DeleteLegacyImages, callInfo)
+	mock.lockDeleteLegacyImages.Unlock()
+	return mock.DeleteLegacyImagesFunc(ctx)
+}
+
+// DeleteLegacyImagesCalls gets all the calls that were made to DeleteLegacyImages.
+// Check the length with:
+//
+//	len(mockedImageSearch.DeleteLegacyImagesCalls())
+func (mock *ImageSearchMock) DeleteLegacyImagesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteLegacyImages.RLock()
+	calls = mock.calls.DeleteLegacyImages
+	mock.lockDeleteLegacyImages.RUnlock()
+	return calls
 }
 
 // IndexImage calls IndexImageFunc.
@@ -0,0 +1,126 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"sync"
+)
+
+// Ensure, that TopicAdminMock does implement messaging.TopicAdmin.
+// If this is not the case, regenerate this file with moq.
+var _ messaging.TopicAdmin = &TopicAdminMock{}
+
+// TopicAdminMock is a mock implementation of messaging.TopicAdmin.
+//
+//	func TestSomethingThatUsesTopicAdmin(t *testing.T) {
+//
+//		// make and configure a mocked messaging.TopicAdmin
+//		mockedTopicAdmin := &TopicAdminMock{
+//			ApplyFunc: func(ctx context.Context, allowPartitionIncrease bool) error {
+//				panic("mock out the Apply method")
+//			},
+//			CheckFunc: func(ctx context.Context) error {
+//				panic("mock out the Check method")
+//			},
+//		}
+//
+//		// use mockedTopicAdmin in code that requires messaging.TopicAdmin
+//		// and then make assertions.
+//
+//	}
+type TopicAdminMock struct {
+	// ApplyFunc mocks the Apply method.
+	ApplyFunc func(ctx context.Context, allowPartitionIncrease bool) error
+
+	// CheckFunc mocks the Check method.
+	CheckFunc func(ctx context.Context) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Apply holds details about calls to the Apply method.
+		Apply []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AllowPartitionIncrease is the allowPartitionIncrease argument value.
+			AllowPartitionIncrease bool
+		}
+		// Check holds details about calls to the Check method.
+		Check []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockApply sync.RWMutex
+	lockCheck sync.RWMutex
+}
+
+// Apply calls ApplyFunc.
+func (mock *TopicAdminMock) Apply(ctx context.Context, allowPartitionIncrease bool) error {
+	if mock.ApplyFunc == nil {
+		panic("TopicAdminMock.ApplyFunc: method is nil but TopicAdmin.Apply was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		AllowPartitionIncrease bool
+	}{
+		Ctx:                    ctx,
+		AllowPartitionIncrease: allowPartitionIncrease,
+	}
+	mock.lockApply.Lock()
+	mock.calls.Apply = append(mock.calls.Apply, callInfo)
+	mock.lockApply.Unlock()
+	return mock.ApplyFunc(ctx, allowPartitionIncrease)
+}
+
+// ApplyCalls gets all the calls that were made to Apply.
+// Check the length with:
+//
+//	len(mockedTopicAdmin.ApplyCalls())
+func (mock *TopicAdminMock) ApplyCalls() []struct {
+	Ctx                    context.Context
+	AllowPartitionIncrease bool
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		AllowPartitionIncrease bool
+	}
+	mock.lockApply.RLock()
+	calls = mock.calls.Apply
+	mock.lockApply.RUnlock()
+	return calls
+}
+
+// Check calls CheckFunc.
+func (mock *TopicAdminMock) Check(ctx context.Context) error {
+	if mock.CheckFunc == nil {
+		panic("TopicAdminMock.CheckFunc: method is nil but TopicAdmin.Check was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCheck.Lock()
+	mock.calls.Check = append(mock.calls.Check, callInfo)
+	mock.lockCheck.Unlock()
+	return mock.CheckFunc(ctx)
+}
+
+// CheckCalls gets all the calls that were made to Check.
+// Check the length with:
+//
+//	len(mockedTopicAdmin.CheckCalls())
+func (mock *TopicAdminMock) CheckCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCheck.RLock()
+	calls = mock.calls.Check
+	mock.lockCheck.RUnlock()
+	return calls
+}
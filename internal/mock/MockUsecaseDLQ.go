@@ -0,0 +1,133 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/dlqusecase"
+	"sync"
+)
+
+// Ensure, that DLQUsecaseMock does implement dlqusecase.DLQUsecase.
+// If this is not the case, regenerate this file with moq.
+var _ dlqusecase.DLQUsecase = &DLQUsecaseMock{}
+
+// DLQUsecaseMock is a mock implementation of dlqusecase.DLQUsecase.
+//
+//	func TestSomethingThatUsesDLQUsecase(t *testing.T) {
+//
+//		// make and configure a mocked dlqusecase.DLQUsecase
+//		mockedDLQUsecase := &DLQUsecaseMock{
+//			ListFunc: func(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error) {
+//				panic("mock out the List method")
+//			},
+//			ReplayFunc: func(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error) {
+//				panic("mock out the Replay method")
+//			},
+//		}
+//
+//		// use mockedDLQUsecase in code that requires dlqusecase.DLQUsecase
+//		// and then make assertions.
+//
+//	}
+type DLQUsecaseMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error)
+
+	// ReplayFunc mocks the Replay method.
+	ReplayFunc func(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.ListDLQRequest
+		}
+		// Replay holds details about calls to the Replay method.
+		Replay []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.ReplayDLQRequest
+		}
+	}
+	lockList   sync.RWMutex
+	lockReplay sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *DLQUsecaseMock) List(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error) {
+	if mock.ListFunc == nil {
+		panic("DLQUsecaseMock.ListFunc: method is nil but DLQUsecase.List was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.ListDLQRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, req)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedDLQUsecase.ListCalls())
+func (mock *DLQUsecaseMock) ListCalls() []struct {
+	Ctx context.Context
+	Req dto.ListDLQRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.ListDLQRequest
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Replay calls ReplayFunc.
+func (mock *DLQUsecaseMock) Replay(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error) {
+	if mock.ReplayFunc == nil {
+		panic("DLQUsecaseMock.ReplayFunc: method is nil but DLQUsecase.Replay was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.ReplayDLQRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockReplay.Lock()
+	mock.calls.Replay = append(mock.calls.Replay, callInfo)
+	mock.lockReplay.Unlock()
+	return mock.ReplayFunc(ctx, req)
+}
+
+// ReplayCalls gets all the calls that were made to Replay.
+// Check the length with:
+//
+//	len(mockedDLQUsecase.ReplayCalls())
+func (mock *DLQUsecaseMock) ReplayCalls() []struct {
+	Ctx context.Context
+	Req dto.ReplayDLQRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.ReplayDLQRequest
+	}
+	mock.lockReplay.RLock()
+	calls = mock.calls.Replay
+	mock.lockReplay.RUnlock()
+	return calls
+}
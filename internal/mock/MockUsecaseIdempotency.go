@@ -20,10 +20,13 @@ var _ idempotencyusecase.IdempotencyUsecase = &IdempotencyUsecaseMock{}
 //
 //		// make and configure a mocked idempotencyusecase.IdempotencyUsecase
 //		mockedIdempotencyUsecase := &IdempotencyUsecaseMock{
+//			DeleteFunc: func(ctx context.Context, consumerGroup string, key string) error {
+//				panic("mock out the Delete method")
+//			},
 //			DeleteOlderThanFunc: func(ctx context.Context, age time.Duration) (int64, error) {
 //				panic("mock out the DeleteOlderThan method")
 //			},
-//			InsertIfNotExistsFunc: func(ctx context.Context, key string, topic string, partition int32, offset int64) (bool, error) {
+//			InsertIfNotExistsFunc: func(ctx context.Context, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error) {
 //				panic("mock out the InsertIfNotExists method")
 //			},
 //		}
@@ -33,14 +36,26 @@ var _ idempotencyusecase.IdempotencyUsecase = &IdempotencyUsecaseMock{}
 //
 //	}
 type IdempotencyUsecaseMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, consumerGroup string, key string) error
+
 	// DeleteOlderThanFunc mocks the DeleteOlderThan method.
 	DeleteOlderThanFunc func(ctx context.Context, age time.Duration) (int64, error)
 
 	// InsertIfNotExistsFunc mocks the InsertIfNotExists method.
-	InsertIfNotExistsFunc func(ctx context.Context, key string, topic string, partition int32, offset int64) (bool, error)
+	InsertIfNotExistsFunc func(ctx context.Context, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ConsumerGroup is the consumerGroup argument value.
+			ConsumerGroup string
+			// Key is the key argument value.
+			Key string
+		}
 		// DeleteOlderThan holds details about calls to the DeleteOlderThan method.
 		DeleteOlderThan []struct {
 			// Ctx is the ctx argument value.
@@ -52,6 +67,8 @@ type IdempotencyUsecaseMock struct {
 		InsertIfNotExists []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// ConsumerGroup is the consumerGroup argument value.
+			ConsumerGroup string
 			// Key is the key argument value.
 			Key string
 			// Topic is the topic argument value.
@@ -62,10 +79,51 @@ type IdempotencyUsecaseMock struct {
 			Offset int64
 		}
 	}
+	lockDelete            sync.RWMutex
 	lockDeleteOlderThan   sync.RWMutex
 	lockInsertIfNotExists sync.RWMutex
 }
 
+// Delete calls DeleteFunc.
+func (mock *IdempotencyUsecaseMock) Delete(ctx context.Context, consumerGroup string, key string) error {
+	if mock.DeleteFunc == nil {
+		panic("IdempotencyUsecaseMock.DeleteFunc: method is nil but IdempotencyUsecase.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ConsumerGroup string
+		Key           string
+	}{
+		Ctx:           ctx,
+		ConsumerGroup: consumerGroup,
+		Key:           key,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, consumerGroup, key)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedIdempotencyUsecase.DeleteCalls())
+func (mock *IdempotencyUsecaseMock) DeleteCalls() []struct {
+	Ctx           context.Context
+	ConsumerGroup string
+	Key           string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ConsumerGroup string
+		Key           string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
 // DeleteOlderThan calls DeleteOlderThanFunc.
 func (mock *IdempotencyUsecaseMock) DeleteOlderThan(ctx context.Context, age time.Duration) (int64, error) {
 	if mock.DeleteOlderThanFunc == nil {
@@ -103,27 +161,29 @@ func (mock *IdempotencyUsecaseMock) DeleteOlderThanCalls() []struct {
 }
 
 // InsertIfNotExists calls InsertIfNotExistsFunc.
-func (mock *IdempotencyUsecaseMock) InsertIfNotExists(ctx context.Context, key string, topic string, partition int32, offset int64) (bool, error) {
+func (mock *IdempotencyUsecaseMock) InsertIfNotExists(ctx context.Context, consumerGroup string, key string, topic string, partition int32, offset int64) (bool, error) {
 	if mock.InsertIfNotExistsFunc == nil {
 		panic("IdempotencyUsecaseMock.InsertIfNotExistsFunc: method is nil but IdempotencyUsecase.InsertIfNotExists was just called")
 	}
 	callInfo := struct {
-		Ctx       context.Context
-		Key       string
-		Topic     string
-		Partition int32
-		Offset    int64
+		Ctx           context.Context
+		ConsumerGroup string
+		Key           string
+		Topic         string
+		Partition     int32
+		Offset        int64
 	}{
-		Ctx:       ctx,
-		Key:       key,
-		Topic:     topic,
-		Partition: partition,
-		Offset:    offset,
+		Ctx:           ctx,
+		ConsumerGroup: consumerGroup,
+		Key:           key,
+		Topic:         topic,
+		Partition:     partition,
+		Offset:        offset,
 	}
 	mock.lockInsertIfNotExists.Lock()
 	mock.calls.InsertIfNotExists = append(mock.calls.InsertIfNotExists, callInfo)
 	mock.lockInsertIfNotExists.Unlock()
-	return mock.InsertIfNotExistsFunc(ctx, key, topic, partition, offset)
+	return mock.InsertIfNotExistsFunc(ctx, consumerGroup, key, topic, partition, offset)
 }
 
 // InsertIfNotExistsCalls gets all the calls that were made to InsertIfNotExists.
@@ -131,18 +191,20 @@ func (mock *IdempotencyUsecaseMock) InsertIfNotExists(ctx context.Context, key s
 //
 //	len(mockedIdempotencyUsecase.InsertIfNotExistsCalls())
 func (mock *IdempotencyUsecaseMock) InsertIfNotExistsCalls() []struct {
-	Ctx       context.Context
-	Key       string
-	Topic     string
-	Partition int32
-	Offset    int64
+	Ctx           context.Context
+	ConsumerGroup string
+	Key           string
+	Topic         string
+	Partition     int32
+	Offset        int64
 } {
 	var calls []struct {
-		Ctx       context.Context
-		Key       string
-		Topic     string
-		Partition int32
-		Offset    int64
+		Ctx           context.Context
+		ConsumerGroup string
+		Key           string
+		Topic         string
+		Partition     int32
+		Offset        int64
 	}
 	mock.lockInsertIfNotExists.RLock()
 	calls = mock.calls.InsertIfNotExists
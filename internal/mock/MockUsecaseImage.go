@@ -29,6 +29,15 @@ var _ imageusecase.ImageUsecase = &ImageUsecaseMock{}
 //			CommentFunc: func(ctx context.Context, req dto.CommentImageRequest) error {
 //				panic("mock out the Comment method")
 //			},
+//			DeleteCommentFunc: func(ctx context.Context, req dto.DeleteCommentRequest) error {
+//				panic("mock out the DeleteComment method")
+//			},
+//			DeleteImageFunc: func(ctx context.Context, req dto.DeleteImageRequest) error {
+//				panic("mock out the DeleteImage method")
+//			},
+//			DeleteImageObjectFunc: func(ctx context.Context, req dto.DeleteImageObjectRequest) error {
+//				panic("mock out the DeleteImageObject method")
+//			},
 //			GetCommentFunc: func(ctx context.Context, req dto.GetCommentRequest) (dto.CommentResponseList, error) {
 //				panic("mock out the GetComment method")
 //			},
@@ -50,9 +59,21 @@ var _ imageusecase.ImageUsecase = &ImageUsecaseMock{}
 //			NotifyUserImageLikedFunc: func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error {
 //				panic("mock out the NotifyUserImageLiked method")
 //			},
+//			RemoveImageFromElasticsearchFunc: func(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error {
+//				panic("mock out the RemoveImageFromElasticsearch method")
+//			},
 //			SyncImageToElasticsearchFunc: func(ctx context.Context, req dto.SyncImageToElasticsearchRequest) error {
 //				panic("mock out the SyncImageToElasticsearch method")
 //			},
+//			UnlikeFunc: func(ctx context.Context, req dto.UnlikeImageRequest) error {
+//				panic("mock out the Unlike method")
+//			},
+//			UpdateCommentFunc: func(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error) {
+//				panic("mock out the UpdateComment method")
+//			},
+//			UpdateImageFunc: func(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error) {
+//				panic("mock out the UpdateImage method")
+//			},
 //			UploadFunc: func(ctx context.Context, req dto.UploadImageRequest) (dto.ImageResponse, error) {
 //				panic("mock out the Upload method")
 //			},
@@ -72,6 +93,15 @@ type ImageUsecaseMock struct {
 	// CommentFunc mocks the Comment method.
 	CommentFunc func(ctx context.Context, req dto.CommentImageRequest) error
 
+	// DeleteCommentFunc mocks the DeleteComment method.
+	DeleteCommentFunc func(ctx context.Context, req dto.DeleteCommentRequest) error
+
+	// DeleteImageFunc mocks the DeleteImage method.
+	DeleteImageFunc func(ctx context.Context, req dto.DeleteImageRequest) error
+
+	// DeleteImageObjectFunc mocks the DeleteImageObject method.
+	DeleteImageObjectFunc func(ctx context.Context, req dto.DeleteImageObjectRequest) error
+
 	// GetCommentFunc mocks the GetComment method.
 	GetCommentFunc func(ctx context.Context, req dto.GetCommentRequest) (dto.CommentResponseList, error)
 
@@ -93,9 +123,21 @@ type ImageUsecaseMock struct {
 	// NotifyUserImageLikedFunc mocks the NotifyUserImageLiked method.
 	NotifyUserImageLikedFunc func(ctx context.Context, req dto.NotifyUserImageLikedRequest) error
 
+	// RemoveImageFromElasticsearchFunc mocks the RemoveImageFromElasticsearch method.
+	RemoveImageFromElasticsearchFunc func(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error
+
 	// SyncImageToElasticsearchFunc mocks the SyncImageToElasticsearch method.
 	SyncImageToElasticsearchFunc func(ctx context.Context, req dto.SyncImageToElasticsearchRequest) error
 
+	// UnlikeFunc mocks the Unlike method.
+	UnlikeFunc func(ctx context.Context, req dto.UnlikeImageRequest) error
+
+	// UpdateCommentFunc mocks the UpdateComment method.
+	UpdateCommentFunc func(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error)
+
+	// UpdateImageFunc mocks the UpdateImage method.
+	UpdateImageFunc func(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error)
+
 	// UploadFunc mocks the Upload method.
 	UploadFunc func(ctx context.Context, req dto.UploadImageRequest) (dto.ImageResponse, error)
 
@@ -122,6 +164,27 @@ type ImageUsecaseMock struct {
 			// Req is the req argument value.
 			Req dto.CommentImageRequest
 		}
+		// DeleteComment holds details about calls to the DeleteComment method.
+		DeleteComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.DeleteCommentRequest
+		}
+		// DeleteImage holds details about calls to the DeleteImage method.
+		DeleteImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.DeleteImageRequest
+		}
+		// DeleteImageObject holds details about calls to the DeleteImageObject method.
+		DeleteImageObject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.DeleteImageObjectRequest
+		}
 		// GetComment holds details about calls to the GetComment method.
 		GetComment []struct {
 			// Ctx is the ctx argument value.
@@ -171,6 +234,13 @@ type ImageUsecaseMock struct {
 			// Req is the req argument value.
 			Req dto.NotifyUserImageLikedRequest
 		}
+		// RemoveImageFromElasticsearch holds details about calls to the RemoveImageFromElasticsearch method.
+		RemoveImageFromElasticsearch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.RemoveImageFromElasticsearchRequest
+		}
 		// SyncImageToElasticsearch holds details about calls to the SyncImageToElasticsearch method.
 		SyncImageToElasticsearch []struct {
 			// Ctx is the ctx argument value.
@@ -178,6 +248,27 @@ type ImageUsecaseMock struct {
 			// Req is the req argument value.
 			Req dto.SyncImageToElasticsearchRequest
 		}
+		// Unlike holds details about calls to the Unlike method.
+		Unlike []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.UnlikeImageRequest
+		}
+		// UpdateComment holds details about calls to the UpdateComment method.
+		UpdateComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.UpdateCommentRequest
+		}
+		// UpdateImage holds details about calls to the UpdateImage method.
+		UpdateImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req dto.UpdateImageRequest
+		}
 		// Upload holds details about calls to the Upload method.
 		Upload []struct {
 			// Ctx is the ctx argument value.
@@ -189,6 +280,9 @@ type ImageUsecaseMock struct {
 	lockBatchUpdateImageCommentCount sync.RWMutex
 	lockBatchUpdateImageLikeCount    sync.RWMutex
 	lockComment                      sync.RWMutex
+	lockDeleteComment                sync.RWMutex
+	lockDeleteImage                  sync.RWMutex
+	lockDeleteImageObject            sync.RWMutex
 	lockGetComment                   sync.RWMutex
 	lockGetImage                     sync.RWMutex
 	lockGetLike                      sync.RWMutex
@@ -196,7 +290,11 @@ type ImageUsecaseMock struct {
 	lockNotifyFollowerOnUpload       sync.RWMutex
 	lockNotifyUserImageCommented     sync.RWMutex
 	lockNotifyUserImageLiked         sync.RWMutex
+	lockRemoveImageFromElasticsearch sync.RWMutex
 	lockSyncImageToElasticsearch     sync.RWMutex
+	lockUnlike                       sync.RWMutex
+	lockUpdateComment                sync.RWMutex
+	lockUpdateImage                  sync.RWMutex
 	lockUpload                       sync.RWMutex
 }
 
@@ -308,6 +406,114 @@ func (mock *ImageUsecaseMock) CommentCalls() []struct {
 	return calls
 }
 
+// DeleteComment calls DeleteCommentFunc.
+func (mock *ImageUsecaseMock) DeleteComment(ctx context.Context, req dto.DeleteCommentRequest) error {
+	if mock.DeleteCommentFunc == nil {
+		panic("ImageUsecaseMock.DeleteCommentFunc: method is nil but ImageUsecase.DeleteComment was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.DeleteCommentRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeleteComment.Lock()
+	mock.calls.DeleteComment = append(mock.calls.DeleteComment, callInfo)
+	mock.lockDeleteComment.Unlock()
+	return mock.DeleteCommentFunc(ctx, req)
+}
+
+// DeleteCommentCalls gets all the calls that were made to DeleteComment.
+// Check the length with:
+//
+//	len(mockedImageUsecase.DeleteCommentCalls())
+func (mock *ImageUsecaseMock) DeleteCommentCalls() []struct {
+	Ctx context.Context
+	Req dto.DeleteCommentRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.DeleteCommentRequest
+	}
+	mock.lockDeleteComment.RLock()
+	calls = mock.calls.DeleteComment
+	mock.lockDeleteComment.RUnlock()
+	return calls
+}
+
+// DeleteImage calls DeleteImageFunc.
+func (mock *ImageUsecaseMock) DeleteImage(ctx context.Context, req dto.DeleteImageRequest) error {
+	if mock.DeleteImageFunc == nil {
+		panic("ImageUsecaseMock.DeleteImageFunc: method is nil but ImageUsecase.DeleteImage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.DeleteImageRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeleteImage.Lock()
+	mock.calls.DeleteImage = append(mock.calls.DeleteImage, callInfo)
+	mock.lockDeleteImage.Unlock()
+	return mock.DeleteImageFunc(ctx, req)
+}
+
+// DeleteImageCalls gets all the calls that were made to DeleteImage.
+// Check the length with:
+//
+//	len(mockedImageUsecase.DeleteImageCalls())
+func (mock *ImageUsecaseMock) DeleteImageCalls() []struct {
+	Ctx context.Context
+	Req dto.DeleteImageRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.DeleteImageRequest
+	}
+	mock.lockDeleteImage.RLock()
+	calls = mock.calls.DeleteImage
+	mock.lockDeleteImage.RUnlock()
+	return calls
+}
+
+// DeleteImageObject calls DeleteImageObjectFunc.
+func (mock *ImageUsecaseMock) DeleteImageObject(ctx context.Context, req dto.DeleteImageObjectRequest) error {
+	if mock.DeleteImageObjectFunc == nil {
+		panic("ImageUsecaseMock.DeleteImageObjectFunc: method is nil but ImageUsecase.DeleteImageObject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.DeleteImageObjectRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeleteImageObject.Lock()
+	mock.calls.DeleteImageObject = append(mock.calls.DeleteImageObject, callInfo)
+	mock.lockDeleteImageObject.Unlock()
+	return mock.DeleteImageObjectFunc(ctx, req)
+}
+
+// DeleteImageObjectCalls gets all the calls that were made to DeleteImageObject.
+// Check the length with:
+//
+//	len(mockedImageUsecase.DeleteImageObjectCalls())
+func (mock *ImageUsecaseMock) DeleteImageObjectCalls() []struct {
+	Ctx context.Context
+	Req dto.DeleteImageObjectRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.DeleteImageObjectRequest
+	}
+	mock.lockDeleteImageObject.RLock()
+	calls = mock.calls.DeleteImageObject
+	mock.lockDeleteImageObject.RUnlock()
+	return calls
+}
+
 // GetComment calls GetCommentFunc.
 func (mock *ImageUsecaseMock) GetComment(ctx context.Context, req dto.GetCommentRequest) (dto.CommentResponseList, error) {
 	if mock.GetCommentFunc == nil {
@@ -560,6 +766,42 @@ func (mock *ImageUsecaseMock) NotifyUserImageLikedCalls() []struct {
 	return calls
 }
 
+// RemoveImageFromElasticsearch calls RemoveImageFromElasticsearchFunc.
+func (mock *ImageUsecaseMock) RemoveImageFromElasticsearch(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error {
+	if mock.RemoveImageFromElasticsearchFunc == nil {
+		panic("ImageUsecaseMock.RemoveImageFromElasticsearchFunc: method is nil but ImageUsecase.RemoveImageFromElasticsearch was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.RemoveImageFromElasticsearchRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockRemoveImageFromElasticsearch.Lock()
+	mock.calls.RemoveImageFromElasticsearch = append(mock.calls.RemoveImageFromElasticsearch, callInfo)
+	mock.lockRemoveImageFromElasticsearch.Unlock()
+	return mock.RemoveImageFromElasticsearchFunc(ctx, req)
+}
+
+// RemoveImageFromElasticsearchCalls gets all the calls that were made to RemoveImageFromElasticsearch.
+// Check the length with:
+//
+//	len(mockedImageUsecase.RemoveImageFromElasticsearchCalls())
+func (mock *ImageUsecaseMock) RemoveImageFromElasticsearchCalls() []struct {
+	Ctx context.Context
+	Req dto.RemoveImageFromElasticsearchRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.RemoveImageFromElasticsearchRequest
+	}
+	mock.lockRemoveImageFromElasticsearch.RLock()
+	calls = mock.calls.RemoveImageFromElasticsearch
+	mock.lockRemoveImageFromElasticsearch.RUnlock()
+	return calls
+}
+
 // SyncImageToElasticsearch calls SyncImageToElasticsearchFunc.
 func (mock *ImageUsecaseMock) SyncImageToElasticsearch(ctx context.Context, req dto.SyncImageToElasticsearchRequest) error {
 	if mock.SyncImageToElasticsearchFunc == nil {
@@ -596,6 +838,114 @@ func (mock *ImageUsecaseMock) SyncImageToElasticsearchCalls() []struct {
 	return calls
 }
 
+// Unlike calls UnlikeFunc.
+func (mock *ImageUsecaseMock) Unlike(ctx context.Context, req dto.UnlikeImageRequest) error {
+	if mock.UnlikeFunc == nil {
+		panic("ImageUsecaseMock.UnlikeFunc: method is nil but ImageUsecase.Unlike was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.UnlikeImageRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockUnlike.Lock()
+	mock.calls.Unlike = append(mock.calls.Unlike, callInfo)
+	mock.lockUnlike.Unlock()
+	return mock.UnlikeFunc(ctx, req)
+}
+
+// UnlikeCalls gets all the calls that were made to Unlike.
+// Check the length with:
+//
+//	len(mockedImageUsecase.UnlikeCalls())
+func (mock *ImageUsecaseMock) UnlikeCalls() []struct {
+	Ctx context.Context
+	Req dto.UnlikeImageRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.UnlikeImageRequest
+	}
+	mock.lockUnlike.RLock()
+	calls = mock.calls.Unlike
+	mock.lockUnlike.RUnlock()
+	return calls
+}
+
+// UpdateComment calls UpdateCommentFunc.
+func (mock *ImageUsecaseMock) UpdateComment(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error) {
+	if mock.UpdateCommentFunc == nil {
+		panic("ImageUsecaseMock.UpdateCommentFunc: method is nil but ImageUsecase.UpdateComment was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.UpdateCommentRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockUpdateComment.Lock()
+	mock.calls.UpdateComment = append(mock.calls.UpdateComment, callInfo)
+	mock.lockUpdateComment.Unlock()
+	return mock.UpdateCommentFunc(ctx, req)
+}
+
+// UpdateCommentCalls gets all the calls that were made to UpdateComment.
+// Check the length with:
+//
+//	len(mockedImageUsecase.UpdateCommentCalls())
+func (mock *ImageUsecaseMock) UpdateCommentCalls() []struct {
+	Ctx context.Context
+	Req dto.UpdateCommentRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.UpdateCommentRequest
+	}
+	mock.lockUpdateComment.RLock()
+	calls = mock.calls.UpdateComment
+	mock.lockUpdateComment.RUnlock()
+	return calls
+}
+
+// UpdateImage calls UpdateImageFunc.
+func (mock *ImageUsecaseMock) UpdateImage(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error) {
+	if mock.UpdateImageFunc == nil {
+		panic("ImageUsecaseMock.UpdateImageFunc: method is nil but ImageUsecase.UpdateImage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req dto.UpdateImageRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockUpdateImage.Lock()
+	mock.calls.UpdateImage = append(mock.calls.UpdateImage, callInfo)
+	mock.lockUpdateImage.Unlock()
+	return mock.UpdateImageFunc(ctx, req)
+}
+
+// UpdateImageCalls gets all the calls that were made to UpdateImage.
+// Check the length with:
+//
+//	len(mockedImageUsecase.UpdateImageCalls())
+func (mock *ImageUsecaseMock) UpdateImageCalls() []struct {
+	Ctx context.Context
+	Req dto.UpdateImageRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req dto.UpdateImageRequest
+	}
+	mock.lockUpdateImage.RLock()
+	calls = mock.calls.UpdateImage
+	mock.lockUpdateImage.RUnlock()
+	return calls
+}
+
 // Upload calls UploadFunc.
 func (mock *ImageUsecaseMock) Upload(ctx context.Context, req dto.UploadImageRequest) (dto.ImageResponse, error) {
 	if mock.UploadFunc == nil {
@@ -0,0 +1,133 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/outboxusecase"
+	"sync"
+	"time"
+)
+
+// Ensure, that OutboxUsecaseMock does implement outboxusecase.OutboxUsecase.
+// If this is not the case, regenerate this file with moq.
+var _ outboxusecase.OutboxUsecase = &OutboxUsecaseMock{}
+
+// OutboxUsecaseMock is a mock implementation of outboxusecase.OutboxUsecase.
+//
+//	func TestSomethingThatUsesOutboxUsecase(t *testing.T) {
+//
+//		// make and configure a mocked outboxusecase.OutboxUsecase
+//		mockedOutboxUsecase := &OutboxUsecaseMock{
+//			CleanupFailedFunc: func(ctx context.Context, age time.Duration) (int64, error) {
+//				panic("mock out the CleanupFailed method")
+//			},
+//			CleanupProducedFunc: func(ctx context.Context, age time.Duration) (int64, error) {
+//				panic("mock out the CleanupProduced method")
+//			},
+//		}
+//
+//		// use mockedOutboxUsecase in code that requires outboxusecase.OutboxUsecase
+//		// and then make assertions.
+//
+//	}
+type OutboxUsecaseMock struct {
+	// CleanupFailedFunc mocks the CleanupFailed method.
+	CleanupFailedFunc func(ctx context.Context, age time.Duration) (int64, error)
+
+	// CleanupProducedFunc mocks the CleanupProduced method.
+	CleanupProducedFunc func(ctx context.Context, age time.Duration) (int64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CleanupFailed holds details about calls to the CleanupFailed method.
+		CleanupFailed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Age is the age argument value.
+			Age time.Duration
+		}
+		// CleanupProduced holds details about calls to the CleanupProduced method.
+		CleanupProduced []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Age is the age argument value.
+			Age time.Duration
+		}
+	}
+	lockCleanupFailed   sync.RWMutex
+	lockCleanupProduced sync.RWMutex
+}
+
+// CleanupFailed calls CleanupFailedFunc.
+func (mock *OutboxUsecaseMock) CleanupFailed(ctx context.Context, age time.Duration) (int64, error) {
+	if mock.CleanupFailedFunc == nil {
+		panic("OutboxUsecaseMock.CleanupFailedFunc: method is nil but OutboxUsecase.CleanupFailed was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Age time.Duration
+	}{
+		Ctx: ctx,
+		Age: age,
+	}
+	mock.lockCleanupFailed.Lock()
+	mock.calls.CleanupFailed = append(mock.calls.CleanupFailed, callInfo)
+	mock.lockCleanupFailed.Unlock()
+	return mock.CleanupFailedFunc(ctx, age)
+}
+
+// CleanupFailedCalls gets all the calls that were made to CleanupFailed.
+// Check the length with:
+//
+//	len(mockedOutboxUsecase.CleanupFailedCalls())
+func (mock *OutboxUsecaseMock) CleanupFailedCalls() []struct {
+	Ctx context.Context
+	Age time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Age time.Duration
+	}
+	mock.lockCleanupFailed.RLock()
+	calls = mock.calls.CleanupFailed
+	mock.lockCleanupFailed.RUnlock()
+	return calls
+}
+
+// CleanupProduced calls CleanupProducedFunc.
+func (mock *OutboxUsecaseMock) CleanupProduced(ctx context.Context, age time.Duration) (int64, error) {
+	if mock.CleanupProducedFunc == nil {
+		panic("OutboxUsecaseMock.CleanupProducedFunc: method is nil but OutboxUsecase.CleanupProduced was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Age time.Duration
+	}{
+		Ctx: ctx,
+		Age: age,
+	}
+	mock.lockCleanupProduced.Lock()
+	mock.calls.CleanupProduced = append(mock.calls.CleanupProduced, callInfo)
+	mock.lockCleanupProduced.Unlock()
+	return mock.CleanupProducedFunc(ctx, age)
+}
+
+// CleanupProducedCalls gets all the calls that were made to CleanupProduced.
+// Check the length with:
+//
+//	len(mockedOutboxUsecase.CleanupProducedCalls())
+func (mock *OutboxUsecaseMock) CleanupProducedCalls() []struct {
+	Ctx context.Context
+	Age time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Age time.Duration
+	}
+	mock.lockCleanupProduced.RLock()
+	calls = mock.calls.CleanupProduced
+	mock.lockCleanupProduced.RUnlock()
+	return calls
+}
@@ -0,0 +1,18 @@
+package messaging
+
+import (
+	"slices"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+)
+
+// codecFor returns the codec events of topicName are produced with. Topics are moved to
+// Protobuf one at a time through config, consumers follow the content-type header.
+func codecFor(cfg *config.Config, topicName topic.Topic) eventkit.Codec {
+	if slices.Contains(cfg.GetKafkaProducerProtobufTopics(), topicName.Primary) {
+		return eventkit.Protobuf
+	}
+	return eventkit.JSON
+}
@@ -0,0 +1,152 @@
+package messaging
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+//go:generate moq -out=../../mock/MockDLQClient.go -pkg=mock . DLQClient
+
+type DLQClient interface {
+	Read(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error
+	Replay(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error
+}
+
+var _ DLQClient = &DLQClientImpl{}
+
+type DLQClientImpl struct {
+	Cfg    *config.Config
+	Client *kgo.Client
+}
+
+func NewDLQClient(cfg *config.Config, client *kgo.Client) *DLQClientImpl {
+	return &DLQClientImpl{
+		Cfg:    cfg,
+		Client: client,
+	}
+}
+
+// Read returns, oldest first, the first limit records of the DLQ of topicName that were
+// produced within [since, until]. A zero since or until leaves that side open, and a zero
+// limit returns every record. The DLQ is read up to its current end, so the read always
+// terminates even while new records keep arriving. Timestamps of a partition are not always
+// in offset order, records dead-lettered by older workers keep the timestamp of the original
+// record, so every record is read and filtered.
+func (c *DLQClientImpl) Read(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error {
+	dlqTopic := topicName.DLQ()
+	adm := kadm.NewClient(c.Client)
+
+	endOffsets, err := adm.ListEndOffsets(ctx, dlqTopic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*DLQClientImpl).Read")
+	}
+
+	startOffsets, err := adm.ListStartOffsets(ctx, dlqTopic)
+	if err == nil {
+		err = startOffsets.Error()
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*DLQClientImpl).Read")
+	}
+
+	partitions := map[int32]kgo.Offset{}
+	remaining := map[int32]int64{}
+	endOffsets.Each(func(end kadm.ListedOffset) {
+		start, ok := startOffsets.Lookup(dlqTopic, end.Partition)
+		if ok && start.Offset < end.Offset {
+			partitions[end.Partition] = kgo.NewOffset().At(start.Offset)
+			remaining[end.Partition] = end.Offset
+		}
+	})
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	// same brokers and credentials as the client. Transaction markers are kept, a partition
+	// ending with one would otherwise never be seen reaching its end.
+	opts := append(c.Client.Opts(),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{dlqTopic: partitions}),
+		kgo.KeepControlRecords(),
+	)
+	consumer, err := kgo.NewClient(opts...)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*DLQClientImpl).Read")
+	}
+	defer consumer.Close()
+
+	read := dto.DLQRecordList{}
+	for len(remaining) > 0 {
+		fetches := consumer.PollFetches(ctx)
+		if ctx.Err() != nil {
+			return errkit.AddFuncName(ctx.Err(), "messaging.(*DLQClientImpl).Read")
+		}
+		if errs := fetches.Errors(); len(errs) > 0 {
+			return errkit.AddFuncName(errs[0].Err, "messaging.(*DLQClientImpl).Read")
+		}
+
+		fetches.EachRecord(func(record *kgo.Record) {
+			end, ok := remaining[record.Partition]
+			if !ok {
+				return
+			}
+			if record.Offset >= end {
+				delete(remaining, record.Partition)
+				return
+			}
+			if record.Offset+1 >= end {
+				delete(remaining, record.Partition)
+			}
+			if record.Attrs.IsControl() {
+				return
+			}
+			if (!since.IsZero() && record.Timestamp.Before(since)) || (!until.IsZero() && record.Timestamp.After(until)) {
+				return
+			}
+
+			dlqRecord := dto.DLQRecord{}
+			converter.KGoRecordToDtoDLQRecord(record, &dlqRecord)
+			read = append(read, dlqRecord)
+		})
+	}
+
+	// partitions are fetched independently and timestamps are not in offset order
+	slices.SortStableFunc(read, func(a, b dto.DLQRecord) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	if limit > 0 && len(read) > limit {
+		read = read[:limit]
+	}
+	*records = append(*records, read...)
+
+	return nil
+}
+
+// Replay produces records back to the primary topic of topicName. Retry and failure headers are
+// dropped so the records start over with a fresh retry count.
+func (c *DLQClientImpl) Replay(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+	kgoRecords := make([]*kgo.Record, 0, len(records))
+	for _, record := range records {
+		kgoRecord := &kgo.Record{}
+		converter.DtoDLQRecordToKGoRecordReplay(record, topicName.Primary, kgoRecord)
+		kgoRecords = append(kgoRecords, kgoRecord)
+	}
+
+	err := c.Client.ProduceSync(ctx, kgoRecords...).FirstErr()
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*DLQClientImpl).Replay")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/sirupsen/logrus"
+)
+
+var _ DLQClient = &DLQClientMwLogger{}
+
+type DLQClientMwLogger struct {
+	Next DLQClient
+}
+
+func NewDLQClientMwLogger(next DLQClient) *DLQClientMwLogger {
+	return &DLQClientMwLogger{
+		Next: next,
+	}
+}
+
+func (c *DLQClientMwLogger) Read(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, records *dto.DLQRecordList) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := c.Next.Read(ctx, topicName, since, until, limit, records)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"topic": topicName.DLQ(),
+		"since": since,
+		"until": until,
+		"limit": limit,
+		"count": len(*records),
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (c *DLQClientMwLogger) Replay(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := c.Next.Replay(ctx, topicName, records)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"topic": topicName.Primary,
+		"count": len(records),
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
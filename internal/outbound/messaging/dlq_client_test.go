@@ -0,0 +1,197 @@
+package messaging_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func newDLQClient(t *testing.T) (*messaging.DLQClientImpl, *kgo.Client) {
+	t.Helper()
+
+	client := newFakeKafkaClientPartitions(t, 2, []string{topic.ImageLiked.Primary, topic.ImageLiked.DLQ()},
+		kgo.RecordPartitioner(kgo.ManualPartitioner()),
+	)
+
+	cfg := config.NewConfig()
+	cfg.Set(config.KafkaBootstrapServers, strings.Join(client.OptValue(kgo.SeedBrokers).([]string), ","))
+
+	return messaging.NewDLQClient(cfg, client), client
+}
+
+func TestDLQClientImpl_Read_Success(t *testing.T) {
+	c, client := newDLQClient(t)
+
+	err := client.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Key: []byte("1"), Value: []byte(`{"image_id":1}`), Headers: []kgo.RecordHeader{
+			{Key: "x-idempotency-key", Value: []byte("key-1")},
+			{Key: "x-retry-count", Value: []byte("3")},
+			{Key: "x-error-message", Value: []byte("boom")},
+		}},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Value: []byte(`{"image_id":2}`)},
+	).FirstErr()
+	require.NoError(t, err)
+
+	records := dto.DLQRecordList{}
+	err = c.Read(context.Background(), topic.ImageLiked, time.Time{}, time.Time{}, 0, &records)
+
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "1", records[0].Key)
+	require.Equal(t, `{"image_id":1}`, records[0].Value)
+	require.Equal(t, 3, records[0].RetryCount)
+	require.Equal(t, "boom", records[0].ErrorMessage)
+	require.Equal(t, int64(1), records[1].Offset)
+}
+
+func TestDLQClientImpl_Read_Empty(t *testing.T) {
+	c, _ := newDLQClient(t)
+
+	records := dto.DLQRecordList{}
+	err := c.Read(context.Background(), topic.ImageLiked, time.Time{}, time.Time{}, 0, &records)
+
+	require.Nil(t, err)
+	require.Empty(t, records)
+}
+
+func TestDLQClientImpl_Read_Success_LimitKeepsOldestAcrossPartitions(t *testing.T) {
+	c, client := newDLQClient(t)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	err := client.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Partition: 0, Timestamp: at(1), Value: []byte("1")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Partition: 0, Timestamp: at(3), Value: []byte("3")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Partition: 0, Timestamp: at(5), Value: []byte("5")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Partition: 1, Timestamp: at(2), Value: []byte("2")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Partition: 1, Timestamp: at(4), Value: []byte("4")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	records := dto.DLQRecordList{}
+	err = c.Read(context.Background(), topic.ImageLiked, time.Time{}, time.Time{}, 3, &records)
+
+	require.Nil(t, err)
+	values := []string{}
+	for _, record := range records {
+		values = append(values, record.Value)
+	}
+	require.Equal(t, []string{"1", "2", "3"}, values)
+}
+
+func TestDLQClientImpl_Read_Success_FiltersUntil(t *testing.T) {
+	c, client := newDLQClient(t)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := client.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: base, Value: []byte("1")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: base.Add(time.Hour), Value: []byte("2")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	records := dto.DLQRecordList{}
+	err = c.Read(context.Background(), topic.ImageLiked, time.Time{}, base.Add(time.Minute), 0, &records)
+
+	require.Nil(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "1", records[0].Value)
+}
+
+func TestDLQClientImpl_Read_Success_TimestampsOutOfOffsetOrder(t *testing.T) {
+	dlqClient, client := newDLQClient(t)
+
+	// records keeping the timestamp of the original record are dead-lettered out of order
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	err := client.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: at(5), Value: []byte("5")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: at(1), Value: []byte("1")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: at(3), Value: []byte("3")},
+		&kgo.Record{Topic: topic.ImageLiked.DLQ(), Timestamp: at(2), Value: []byte("2")},
+	).FirstErr()
+	require.NoError(t, err)
+
+	for name, c := range map[string]struct {
+		since time.Time
+		until time.Time
+		limit int
+		want  []string
+	}{
+		"window":       {since: at(2), until: at(4), want: []string{"2", "3"}},
+		"since only":   {since: at(3), want: []string{"3", "5"}},
+		"until only":   {until: at(2), want: []string{"1", "2"}},
+		"oldest limit": {limit: 2, want: []string{"1", "2"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			records := dto.DLQRecordList{}
+			err := dlqClient.Read(context.Background(), topic.ImageLiked, c.since, c.until, c.limit, &records)
+
+			require.Nil(t, err)
+			values := []string{}
+			for _, record := range records {
+				values = append(values, record.Value)
+			}
+			require.Equal(t, c.want, values)
+		})
+	}
+}
+
+func TestDLQClientImpl_Read_Success_EndsOnTransactionMarker(t *testing.T) {
+	c, client := newDLQClient(t)
+
+	// the commit marker takes the last offset, no record is ever fetched at it
+	txnClient, err := kgo.NewClient(
+		kgo.SeedBrokers(client.OptValue(kgo.SeedBrokers).([]string)...),
+		kgo.TransactionalID("test.dlq"),
+	)
+	require.NoError(t, err)
+	defer txnClient.Close()
+
+	require.NoError(t, txnClient.BeginTransaction())
+	err = txnClient.ProduceSync(context.Background(), &kgo.Record{Topic: topic.ImageLiked.DLQ(), Value: []byte("1")}).FirstErr()
+	require.NoError(t, err)
+	require.NoError(t, txnClient.EndTransaction(context.Background(), kgo.TryCommit))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	records := dto.DLQRecordList{}
+	err = c.Read(ctx, topic.ImageLiked, time.Time{}, time.Time{}, 0, &records)
+
+	require.Nil(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "1", records[0].Value)
+}
+
+func TestDLQClientImpl_Replay_Success(t *testing.T) {
+	c, client := newDLQClient(t)
+
+	err := c.Replay(context.Background(), topic.ImageLiked, dto.DLQRecordList{
+		{Key: "1", Value: `{"image_id":1}`, Headers: dto.DLQHeaderList{
+			{Key: "x-idempotency-key", Value: "key-1"},
+			{Key: "x-retry-count", Value: "3"},
+			{Key: "x-retry-due-at", Value: "0"},
+			{Key: "x-error-message", Value: "boom"},
+			{Key: "x-original-topic", Value: "image.liked"},
+		}},
+	})
+	require.Nil(t, err)
+
+	client.AddConsumeTopics(topic.ImageLiked.Primary)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fetches := client.PollRecords(ctx, 1)
+	require.Empty(t, fetches.Errors())
+
+	replayed := fetches.Records()
+	require.Len(t, replayed, 1)
+	require.Equal(t, "1", string(replayed[0].Key))
+	require.Equal(t, []kgo.RecordHeader{{Key: "x-idempotency-key", Value: []byte("key-1")}}, replayed[0].Headers)
+}
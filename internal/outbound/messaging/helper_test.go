@@ -0,0 +1,52 @@
+package messaging_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newFakeDB(t testing.TB) (gormDB *gorm.DB, sqlMockDB sqlmock.Sqlmock) {
+	t.Helper()
+
+	var sqlDB *sql.DB
+	var err error
+
+	sqlDB, sqlMockDB, err = sqlmock.New()
+	require.NoError(t, err)
+
+	gormDB, err = gorm.Open(postgres.New(postgres.Config{Conn: sqlDB, PreferSimpleProtocol: true}), &gorm.Config{})
+	require.NoError(t, err)
+
+	return gormDB, sqlMockDB
+}
+
+// newFakeKafkaClient starts an in-memory kafka cluster with the given topics
+// and returns a client connected to it.
+func newFakeKafkaClient(t testing.TB, topics []string, opts ...kgo.Opt) *kgo.Client {
+	t.Helper()
+	return newFakeKafkaClientPartitions(t, 1, topics, opts...)
+}
+
+// newFakeKafkaClientPartitions is newFakeKafkaClient with the given number of partitions
+// per topic.
+func newFakeKafkaClientPartitions(t testing.TB, partitions int32, topics []string, opts ...kgo.Opt) *kgo.Client {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, topics...))
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+
+	opts = append(opts, kgo.SeedBrokers(cluster.ListenAddrs()...))
+	client, err := kgo.NewClient(opts...)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	return client
+}
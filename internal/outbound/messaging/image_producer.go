@@ -2,16 +2,20 @@ package messaging
 
 import (
 	"context"
-	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -19,8 +23,12 @@ import (
 
 type ImageProducer interface {
 	SendImageUploaded(ctx context.Context, db *gorm.DB, event *dto.ImageUploadedEvent) error
+	SendImageUpdated(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error
+	SendImageDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error
 	SendImageLiked(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error
+	SendImageUnliked(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error
 	SendImageCommented(ctx context.Context, db *gorm.DB, event *dto.ImageCommentedEvent) error
+	SendImageCommentDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error
 }
 
 var _ ImageProducer = &ImageProducerImpl{}
@@ -38,45 +46,83 @@ func NewImageProducer(cfg *config.Config, outboxRepository repository.OutboxRepo
 }
 
 func (p *ImageProducerImpl) SendImageUploaded(ctx context.Context, db *gorm.DB, event *dto.ImageUploadedEvent) error {
-	err := p.send(ctx, db, topic.ImageUploaded, event)
+	err := p.send(ctx, db, topic.ImageUploaded, eventschema.ImageUploaded, strconv.FormatInt(event.ID, 10), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageUploaded")
 	}
 	return nil
 }
 
+func (p *ImageProducerImpl) SendImageUpdated(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+	err := p.send(ctx, db, topic.ImageUpdated, eventschema.ImageUpdated, strconv.FormatInt(event.ID, 10), event)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageUpdated")
+	}
+	return nil
+}
+
+func (p *ImageProducerImpl) SendImageDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+	err := p.send(ctx, db, topic.ImageDeleted, eventschema.ImageDeleted, strconv.FormatInt(event.ID, 10), event)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageDeleted")
+	}
+	return nil
+}
+
 func (p *ImageProducerImpl) SendImageLiked(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error {
-	err := p.send(ctx, db, topic.ImageLiked, event)
+	err := p.send(ctx, db, topic.ImageLiked, eventschema.ImageLiked, strconv.FormatInt(event.ImageID, 10), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageLiked")
 	}
 	return nil
 }
 
+func (p *ImageProducerImpl) SendImageUnliked(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+	err := p.send(ctx, db, topic.ImageUnliked, eventschema.ImageUnliked, strconv.FormatInt(event.ImageID, 10), event)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageUnliked")
+	}
+	return nil
+}
+
 func (p *ImageProducerImpl) SendImageCommented(ctx context.Context, db *gorm.DB, event *dto.ImageCommentedEvent) error {
-	err := p.send(ctx, db, topic.ImageCommented, event)
+	err := p.send(ctx, db, topic.ImageCommented, eventschema.ImageCommented, strconv.FormatInt(event.ImageID, 10), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageCommented")
 	}
 	return nil
 }
 
-func (p *ImageProducerImpl) send(ctx context.Context, db *gorm.DB, topicName topic.Topic, event any) error {
+func (p *ImageProducerImpl) SendImageCommentDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+	err := p.send(ctx, db, topic.ImageCommentDeleted, eventschema.ImageCommentDeleted, strconv.FormatInt(event.ImageID, 10), event)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).SendImageCommentDeleted")
+	}
+	return nil
+}
+
+func (p *ImageProducerImpl) send(ctx context.Context, db *gorm.DB, topicName topic.Topic, schema eventkit.Schema, key string, event any) error {
 	if !p.Cfg.GetKafkaProducerEnabled() {
 		logkit.Logger.WithContext(ctx).Warn("Kafka producer is disabled")
 		return nil
 	}
 
-	value, err := json.Marshal(event)
+	// the event id doubles as the idempotency key, both identify this one event
+	id := uuid.New().String()
+	codec := codecFor(p.Cfg, topicName)
+	value, err := schema.Marshal(codec, id, p.Cfg.GetAppName(), key, time.Now(), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).send")
 	}
 
 	outbox := entity.Outbox{
-		Topic:        topicName.Primary,
-		Payload:      value,
-		TraceContext: telemetry.InjectTraceContext(ctx),
-		Status:       entity.OutboxStatusPending,
+		Topic:          topicName.Primary,
+		Key:            key,
+		Payload:        value,
+		ContentType:    codec.ContentType(),
+		TraceContext:   telemetry.InjectTraceContext(ctx),
+		IdempotencyKey: id,
+		Status:         entity.OutboxStatusPending,
 	}
 
 	err = p.OutboxRepository.Insert(ctx, db, &outbox)
@@ -84,7 +130,7 @@ func (p *ImageProducerImpl) send(ctx context.Context, db *gorm.DB, topicName top
 		return errkit.AddFuncName(err, "messaging.(*ImageProducerImpl).send")
 	}
 
-	logkit.Logger.WithContext(ctx).WithField("topic", topicName.Primary).Debug("outbox record inserted")
+	logkit.Logger.WithContext(ctx).WithField("topic", topicName.Primary).WithField("key", key).Debug("outbox record inserted")
 
 	return nil
 }
@@ -37,6 +37,36 @@ func (p *ImageProducerMwLogger) SendImageUploaded(ctx context.Context, db *gorm.
 	return err
 }
 
+func (p *ImageProducerMwLogger) SendImageUpdated(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := p.Next.SendImageUpdated(ctx, db, event)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"event": event,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (p *ImageProducerMwLogger) SendImageDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := p.Next.SendImageDeleted(ctx, db, event)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"event": event,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (p *ImageProducerMwLogger) SendImageLiked(ctx context.Context, db *gorm.DB, event *dto.ImageLikedEvent) error {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -52,6 +82,21 @@ func (p *ImageProducerMwLogger) SendImageLiked(ctx context.Context, db *gorm.DB,
 	return err
 }
 
+func (p *ImageProducerMwLogger) SendImageUnliked(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := p.Next.SendImageUnliked(ctx, db, event)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"event": event,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (p *ImageProducerMwLogger) SendImageCommented(ctx context.Context, db *gorm.DB, event *dto.ImageCommentedEvent) error {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -66,3 +111,18 @@ func (p *ImageProducerMwLogger) SendImageCommented(ctx context.Context, db *gorm
 
 	return err
 }
+
+func (p *ImageProducerMwLogger) SendImageCommentDeleted(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := p.Next.SendImageCommentDeleted(ctx, db, event)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"event": event,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
@@ -2,14 +2,16 @@ package messaging
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
-	"github.com/google/uuid"
 	"github.com/twmb/franz-go/pkg/kgo"
 	"gorm.io/gorm"
 )
@@ -60,24 +62,20 @@ func (p *OutboxProducerImpl) ProducePending(ctx context.Context) error {
 			return nil
 		}
 
-		var producedIDs []int64
-		for _, outbox := range outboxes {
-			recordCtx := telemetry.ExtractTraceContext(ctx, outbox.TraceContext)
-			idempotencyKey := uuid.New().String()
-			record := &kgo.Record{
-				Topic: outbox.Topic,
-				Value: outbox.Payload,
-				Headers: []kgo.RecordHeader{
-					{Key: "x-idempotency-key", Value: []byte(idempotencyKey)},
-				},
-			}
+		produceErrs := p.produceAll(ctx, outboxes)
 
-			result := p.Client.ProduceSync(recordCtx, record)
-			err = result.FirstErr()
-			if err != nil {
-				logkit.Logger.WithContext(ctx).WithError(err).
+		var producedIDs []int64
+		for i, outbox := range outboxes {
+			produceErr := produceErrs[i]
+			if produceErr != nil {
+				logkit.Logger.WithContext(ctx).WithError(produceErr).
 					WithField("outbox_id", outbox.ID).
 					Error("failed to produce outbox record to Kafka")
+
+				err = p.markAttemptFailed(ctx, tx, outbox, produceErr)
+				if err != nil {
+					return errkit.AddFuncName(err, "messaging.(*OutboxProducerImpl).ProducePending")
+				}
 				continue
 			}
 
@@ -99,3 +97,86 @@ func (p *OutboxProducerImpl) ProducePending(ctx context.Context) error {
 		return nil
 	})
 }
+
+// produceAll hands every outbox record to the client at once and waits for all produce
+// callbacks, so the batch costs roughly one broker round trip instead of one per record.
+// The returned slice holds the produce error of each outbox at the same index.
+func (p *OutboxProducerImpl) produceAll(ctx context.Context, outboxes entity.OutboxList) []error {
+	produceErrs := make([]error, len(outboxes))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(outboxes))
+	for i, outbox := range outboxes {
+		recordCtx := telemetry.ExtractTraceContext(ctx, outbox.TraceContext)
+		record := &kgo.Record{
+			Topic: outbox.Topic,
+			Value: outbox.Payload,
+			Headers: []kgo.RecordHeader{
+				{Key: header.IdempotencyKey, Value: []byte(outbox.IdempotencyKey)},
+			},
+		}
+		if outbox.ContentType != "" {
+			record.Headers = append(record.Headers, kgo.RecordHeader{Key: header.ContentType, Value: []byte(outbox.ContentType)})
+		}
+		if outbox.Key != "" {
+			// same key always lands on the same partition, so events of one aggregate stay ordered
+			record.Key = []byte(outbox.Key)
+		}
+
+		p.Client.Produce(recordCtx, record, func(_ *kgo.Record, err error) {
+			// each callback writes only its own index, so no lock is needed
+			produceErrs[i] = err
+			wg.Done()
+		})
+	}
+
+	// the batch is complete, so skip the client linger and send it right away
+	err := p.Client.Flush(ctx)
+	if err != nil {
+		logkit.Logger.WithContext(ctx).WithError(err).Warn("failed to flush outbox records to Kafka")
+	}
+	wg.Wait()
+
+	return produceErrs
+}
+
+// markAttemptFailed records a failed produce attempt. The record is retried with
+// exponential backoff until it reaches the configured max attempts, then it is
+// moved to the failed status so it stops being picked up by FindPending.
+func (p *OutboxProducerImpl) markAttemptFailed(ctx context.Context, tx *gorm.DB, outbox entity.Outbox, produceErr error) error {
+	attempts := outbox.Attempts + 1
+
+	if attempts >= p.Cfg.GetOutboxMaxAttempts() {
+		logkit.Logger.WithContext(ctx).WithError(produceErr).
+			WithField("outbox_id", outbox.ID).
+			WithField("attempts", attempts).
+			Error("outbox record reached max attempts, marking as failed")
+
+		err := p.OutboxRepository.MarkFailed(ctx, tx, outbox.ID, attempts, produceErr.Error())
+		if err != nil {
+			return errkit.AddFuncName(err, "messaging.(*OutboxProducerImpl).markAttemptFailed")
+		}
+		return nil
+	}
+
+	backoff := time.Duration(p.Cfg.GetOutboxBackoffSeconds()) * time.Second
+	maxBackoff := time.Duration(p.Cfg.GetOutboxMaxBackoffSeconds()) * time.Second
+	nextAttemptAt := time.Now().Add(outboxBackoff(attempts, backoff, maxBackoff))
+
+	err := p.OutboxRepository.MarkRetry(ctx, tx, outbox.ID, attempts, produceErr.Error(), nextAttemptAt)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*OutboxProducerImpl).markAttemptFailed")
+	}
+	return nil
+}
+
+// outboxBackoff returns backoff doubled for every attempt after the first, capped at maxBackoff.
+func outboxBackoff(attempts int, backoff time.Duration, maxBackoff time.Duration) time.Duration {
+	const maxShift = 30
+	shift := min(attempts-1, maxShift)
+	d := backoff << shift
+	if d <= 0 || d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
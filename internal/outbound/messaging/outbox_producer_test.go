@@ -0,0 +1,179 @@
+package messaging_test
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"gorm.io/gorm"
+)
+
+const testProducerBatchMaxBytes = 1024
+
+func newOutboxProducer(t *testing.T, outboxes entity.OutboxList) (*messaging.OutboxProducerImpl, *mock.OutboxRepositoryMock) {
+	t.Helper()
+
+	gormDB, mockDB := newFakeDB(t)
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	client := newFakeKafkaClient(t, []string{topic.ImageLiked.Primary}, kgo.ProducerBatchMaxBytes(testProducerBatchMaxBytes))
+
+	cfg := config.NewConfig()
+	cfg.Set(config.OutboxMaxAttempts, 3)
+	cfg.Set(config.OutboxBackoffSeconds, 10)
+	cfg.Set(config.OutboxMaxBackoffSeconds, 60)
+
+	OutboxRepository := &mock.OutboxRepositoryMock{
+		FindPendingFunc: func(ctx context.Context, db *gorm.DB, outboxesMoqParam *entity.OutboxList, limit int) error {
+			*outboxesMoqParam = outboxes
+			return nil
+		},
+		MarkProducedFunc: func(ctx context.Context, db *gorm.DB, ids []int64) error {
+			return nil
+		},
+		MarkRetryFunc: func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+			return nil
+		},
+		MarkFailedFunc: func(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+			return nil
+		},
+	}
+
+	p := messaging.NewOutboxProducer(cfg, gormDB, client, OutboxRepository)
+
+	return p, OutboxRepository
+}
+
+func TestOutboxProducerImpl_ProducePending_Success(t *testing.T) {
+	p, OutboxRepository := newOutboxProducer(t, entity.OutboxList{
+		{ID: 1, Topic: topic.ImageLiked.Primary, Key: "100", Payload: []byte(`{}`), IdempotencyKey: "key-1"},
+	})
+
+	err := p.ProducePending(context.Background())
+
+	require.Nil(t, err)
+	require.Len(t, OutboxRepository.MarkProducedCalls(), 1)
+	require.Equal(t, []int64{1}, OutboxRepository.MarkProducedCalls()[0].Ids)
+	require.Empty(t, OutboxRepository.MarkRetryCalls())
+	require.Empty(t, OutboxRepository.MarkFailedCalls())
+}
+
+func TestOutboxProducerImpl_ProducePending_Fail_MarkRetry(t *testing.T) {
+	oversized := bytes.Repeat([]byte("x"), testProducerBatchMaxBytes*2)
+	p, OutboxRepository := newOutboxProducer(t, entity.OutboxList{
+		{ID: 1, Topic: topic.ImageLiked.Primary, Payload: oversized, IdempotencyKey: "key-1", Attempts: 1},
+		{ID: 2, Topic: topic.ImageLiked.Primary, Payload: []byte(`{}`), IdempotencyKey: "key-2"},
+	})
+
+	before := time.Now()
+	err := p.ProducePending(context.Background())
+
+	require.Nil(t, err)
+	require.Equal(t, []int64{2}, OutboxRepository.MarkProducedCalls()[0].Ids)
+	require.Empty(t, OutboxRepository.MarkFailedCalls())
+
+	calls := OutboxRepository.MarkRetryCalls()
+	require.Len(t, calls, 1)
+	require.Equal(t, int64(1), calls[0].ID)
+	require.Equal(t, 2, calls[0].Attempts)
+	require.NotEmpty(t, calls[0].LastError)
+	// second attempt doubles the 10s backoff
+	require.WithinDuration(t, before.Add(20*time.Second), calls[0].NextAttemptAt, 5*time.Second)
+}
+
+func TestOutboxProducerImpl_ProducePending_Fail_MarkFailed(t *testing.T) {
+	oversized := bytes.Repeat([]byte("x"), testProducerBatchMaxBytes*2)
+	p, OutboxRepository := newOutboxProducer(t, entity.OutboxList{
+		{ID: 1, Topic: topic.ImageLiked.Primary, Payload: oversized, IdempotencyKey: "key-1", Attempts: 2},
+	})
+
+	err := p.ProducePending(context.Background())
+
+	require.Nil(t, err)
+	require.Empty(t, OutboxRepository.MarkProducedCalls())
+	require.Empty(t, OutboxRepository.MarkRetryCalls())
+
+	calls := OutboxRepository.MarkFailedCalls()
+	require.Len(t, calls, 1)
+	require.Equal(t, int64(1), calls[0].ID)
+	require.Equal(t, 3, calls[0].Attempts)
+	require.NotEmpty(t, calls[0].LastError)
+}
+
+const benchmarkOutboxBatchSize = 100
+
+func newBenchmarkOutboxes() entity.OutboxList {
+	outboxes := make(entity.OutboxList, benchmarkOutboxBatchSize)
+	for i := range outboxes {
+		outboxes[i] = entity.Outbox{
+			ID:             int64(i + 1),
+			Topic:          topic.ImageLiked.Primary,
+			Key:            strconv.Itoa(i % 10),
+			Payload:        []byte(`{"image_id":1,"user_id":1}`),
+			IdempotencyKey: strconv.Itoa(i),
+		}
+	}
+	return outboxes
+}
+
+// BenchmarkOutboxProducerImpl_ProducePending measures a pipelined batch against the
+// in-memory broker. Compare with BenchmarkOutboxProducer_ProduceSyncPerRecord, which
+// replays the same batch one round trip at a time.
+func BenchmarkOutboxProducerImpl_ProducePending(b *testing.B) {
+	outboxes := newBenchmarkOutboxes()
+
+	gormDB, mockDB := newFakeDB(b)
+	client := newFakeKafkaClient(b, []string{topic.ImageLiked.Primary})
+
+	OutboxRepository := &mock.OutboxRepositoryMock{
+		FindPendingFunc: func(ctx context.Context, db *gorm.DB, outboxesMoqParam *entity.OutboxList, limit int) error {
+			*outboxesMoqParam = outboxes
+			return nil
+		},
+		MarkProducedFunc: func(ctx context.Context, db *gorm.DB, ids []int64) error {
+			return nil
+		},
+	}
+
+	p := messaging.NewOutboxProducer(config.NewConfig(), gormDB, client, OutboxRepository)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		err := p.ProducePending(context.Background())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(b.N*benchmarkOutboxBatchSize)/b.Elapsed().Seconds(), "records/s")
+}
+
+func BenchmarkOutboxProducer_ProduceSyncPerRecord(b *testing.B) {
+	outboxes := newBenchmarkOutboxes()
+
+	client := newFakeKafkaClient(b, []string{topic.ImageLiked.Primary})
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, outbox := range outboxes {
+			record := &kgo.Record{Topic: outbox.Topic, Key: []byte(outbox.Key), Value: outbox.Payload}
+			err := client.ProduceSync(context.Background(), record).FirstErr()
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.ReportMetric(float64(b.N*benchmarkOutboxBatchSize)/b.Elapsed().Seconds(), "records/s")
+}
@@ -0,0 +1,205 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+//go:generate moq -out=../../mock/MockTopicAdmin.go -pkg=mock . TopicAdmin
+
+// TopicAdmin provisions the topics of topic.Manifest, whose retry tiers follow config
+// kafka.consumer.retry_delays_seconds.
+type TopicAdmin interface {
+	// Apply creates missing topics and sets the retention of existing topics. Applying an
+	// unchanged manifest again changes nothing. Missing partitions are only added when
+	// allowPartitionIncrease is set, otherwise Apply fails listing the topics that lack them.
+	Apply(ctx context.Context, allowPartitionIncrease bool) error
+	// Check fails when a topic of the manifest does not exist.
+	Check(ctx context.Context) error
+}
+
+var _ TopicAdmin = &TopicAdminImpl{}
+
+type TopicAdminImpl struct {
+	Cfg    *config.Config
+	Client *kgo.Client
+}
+
+func NewTopicAdmin(cfg *config.Config, client *kgo.Client) *TopicAdminImpl {
+	return &TopicAdminImpl{
+		Cfg:    cfg,
+		Client: client,
+	}
+}
+
+func (a *TopicAdminImpl) manifest() []topic.Spec {
+	return topic.Manifest(len(a.Cfg.GetKafkaConsumerRetryDelaysSeconds()))
+}
+
+func retentionConfig(spec topic.Spec) map[string]*string {
+	return map[string]*string{
+		"retention.ms": kadm.StringPtr(strconv.FormatInt(spec.Retention.Milliseconds(), 10)),
+	}
+}
+
+// list returns the partition count of the existing topics of specs. It skips the metadata
+// cache of the client, which may still hold topics as they were before Apply.
+func (a *TopicAdminImpl) list(ctx context.Context, specs []topic.Spec) (map[string]int32, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	for _, spec := range specs {
+		requestTopic := kmsg.NewMetadataRequestTopic()
+		requestTopic.Topic = kmsg.StringPtr(spec.Name)
+		req.Topics = append(req.Topics, requestTopic)
+	}
+
+	resp, err := req.RequestWith(ctx, a.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := map[string]int32{}
+	for _, t := range resp.Topics {
+		name := ""
+		if t.Topic != nil {
+			name = *t.Topic
+		}
+		err = kerr.ErrorForCode(t.ErrorCode)
+		if errors.Is(err, kerr.UnknownTopicOrPartition) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list topic %s: %w", name, err)
+		}
+		partitions[name] = int32(len(t.Partitions))
+	}
+
+	return partitions, nil
+}
+
+func (a *TopicAdminImpl) Apply(ctx context.Context, allowPartitionIncrease bool) error {
+	adm := kadm.NewClient(a.Client)
+	specs := a.manifest()
+
+	existing, err := a.list(ctx, specs)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*TopicAdminImpl).Apply")
+	}
+
+	lackingPartitions := []string{}
+	for _, spec := range specs {
+		partitions, ok := existing[spec.Name]
+		if ok {
+			if partitions < spec.Partitions && !allowPartitionIncrease {
+				lackingPartitions = append(lackingPartitions, spec.Name)
+			}
+			err = a.update(ctx, adm, spec, partitions, allowPartitionIncrease)
+		} else {
+			err = a.create(ctx, adm, spec)
+		}
+		if err != nil {
+			return errkit.AddFuncName(err, "messaging.(*TopicAdminImpl).Apply")
+		}
+	}
+
+	if len(lackingPartitions) > 0 {
+		err = fmt.Errorf("topics %s have fewer partitions than declared, adding partitions moves keys to other partitions "+
+			"so records of one key may be consumed out of order until the old partitions are drained, "+
+			"add them with: go run cmd/topic/main.go apply -allow-partition-increase", strings.Join(lackingPartitions, ", "))
+		return errkit.AddFuncName(err, "messaging.(*TopicAdminImpl).Apply")
+	}
+
+	return nil
+}
+
+func (a *TopicAdminImpl) create(ctx context.Context, adm *kadm.Client, spec topic.Spec) error {
+	responses, err := adm.CreateTopics(ctx, spec.Partitions, a.Cfg.GetKafkaTopicReplicationFactor(), retentionConfig(spec), spec.Name)
+	if err == nil {
+		err = responses.Error()
+	}
+	// another instance created it in the meantime
+	if errors.Is(err, kerr.TopicAlreadyExists) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("create topic %s: %w", spec.Name, err)
+	}
+
+	logkit.Logger.WithContext(ctx).WithField("topic", spec.Name).WithField("partitions", spec.Partitions).Info("topic created")
+
+	return nil
+}
+
+// update sets the retention of an existing topic and, when allowPartitionIncrease is set,
+// adds its missing partitions. Kafka can not remove partitions nor change the replication
+// factor in place, so topics with more partitions are left as they are.
+func (a *TopicAdminImpl) update(ctx context.Context, adm *kadm.Client, spec topic.Spec, partitions int32, allowPartitionIncrease bool) error {
+	switch {
+	case partitions < spec.Partitions && !allowPartitionIncrease:
+		logkit.Logger.WithContext(ctx).WithField("topic", spec.Name).WithField("partitions", partitions).
+			Warnf("topic has fewer partitions than the %d declared, not adding them without -allow-partition-increase", spec.Partitions)
+	case partitions < spec.Partitions:
+		responses, err := adm.UpdatePartitions(ctx, int(spec.Partitions), spec.Name)
+		if err == nil {
+			err = responses.Error()
+		}
+		if err != nil {
+			return fmt.Errorf("update partitions of topic %s: %w", spec.Name, err)
+		}
+		logkit.Logger.WithContext(ctx).WithField("topic", spec.Name).WithField("partitions", spec.Partitions).
+			Warn("topic partitions added, records of one key may be consumed out of order until the old partitions are drained")
+	case partitions > spec.Partitions:
+		logkit.Logger.WithContext(ctx).WithField("topic", spec.Name).WithField("partitions", partitions).
+			Warnf("topic has more partitions than the %d declared, Kafka can not remove partitions", spec.Partitions)
+	}
+
+	alterConfigs := []kadm.AlterConfig{}
+	for name, value := range retentionConfig(spec) {
+		alterConfigs = append(alterConfigs, kadm.AlterConfig{Op: kadm.SetConfig, Name: name, Value: value})
+	}
+	responses, err := adm.AlterTopicConfigs(ctx, alterConfigs, spec.Name)
+	if err == nil {
+		for _, response := range responses {
+			err = errors.Join(err, response.Err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("alter configs of topic %s: %w", spec.Name, err)
+	}
+
+	return nil
+}
+
+func (a *TopicAdminImpl) Check(ctx context.Context) error {
+	specs := a.manifest()
+
+	existing, err := a.list(ctx, specs)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*TopicAdminImpl).Check")
+	}
+
+	missing := []string{}
+	for _, spec := range specs {
+		_, ok := existing[spec.Name]
+		if !ok {
+			missing = append(missing, spec.Name)
+		}
+	}
+	if len(missing) > 0 {
+		err = fmt.Errorf("missing topics %s, provision them with: go run cmd/topic/main.go apply", strings.Join(missing, ", "))
+		return errkit.AddFuncName(err, "messaging.(*TopicAdminImpl).Check")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/sirupsen/logrus"
+)
+
+var _ TopicAdmin = &TopicAdminMwLogger{}
+
+type TopicAdminMwLogger struct {
+	Next TopicAdmin
+}
+
+func NewTopicAdminMwLogger(next TopicAdmin) *TopicAdminMwLogger {
+	return &TopicAdminMwLogger{
+		Next: next,
+	}
+}
+
+func (a *TopicAdminMwLogger) Apply(ctx context.Context, allowPartitionIncrease bool) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := a.Next.Apply(ctx, allowPartitionIncrease)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"allowPartitionIncrease": allowPartitionIncrease,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (a *TopicAdminMwLogger) Check(ctx context.Context) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := a.Next.Check(ctx)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
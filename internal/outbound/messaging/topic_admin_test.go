@@ -0,0 +1,96 @@
+package messaging_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+func newTopicAdmin(t *testing.T, topics ...string) (*messaging.TopicAdminImpl, *kadm.Client) {
+	t.Helper()
+
+	client := newFakeKafkaClient(t, topics)
+
+	cfg := config.NewConfig()
+	cfg.Set(config.KafkaConsumerRetryDelaysSeconds, []int{10, 60})
+
+	return messaging.NewTopicAdmin(cfg, client), kadm.NewClient(client)
+}
+
+func TestTopicAdminImpl_Apply_CreatesManifest(t *testing.T) {
+	admin, adm := newTopicAdmin(t)
+	ctx := context.Background()
+
+	require.Error(t, admin.Check(ctx))
+
+	require.NoError(t, admin.Apply(ctx, false))
+	require.NoError(t, admin.Check(ctx))
+
+	manifest := topic.Manifest(2)
+	names := []string{}
+	for _, spec := range manifest {
+		names = append(names, spec.Name)
+	}
+	details, err := adm.ListTopics(ctx, names...)
+	require.NoError(t, err)
+	for _, spec := range manifest {
+		require.NoError(t, details[spec.Name].Err, spec.Name)
+		require.Len(t, details[spec.Name].Partitions, int(spec.Partitions), spec.Name)
+	}
+
+	configs, err := adm.DescribeTopicConfigs(ctx, topic.ImageLiked.DLQ())
+	require.NoError(t, err)
+	dlqConfigs, err := configs.On(topic.ImageLiked.DLQ(), nil)
+	require.NoError(t, err)
+	retention := ""
+	for _, c := range dlqConfigs.Configs {
+		if c.Key == "retention.ms" {
+			retention = c.MaybeValue()
+		}
+	}
+	require.Equal(t, strconv.FormatInt(topic.DLQRetention.Milliseconds(), 10), retention)
+}
+
+func TestTopicAdminImpl_Apply_IsIdempotentAndAddsMissingPartitions(t *testing.T) {
+	// image.liked exists with one partition, as auto creation used to leave it
+	admin, adm := newTopicAdmin(t, topic.ImageLiked.Primary)
+	ctx := context.Background()
+
+	require.NoError(t, admin.Apply(ctx, true))
+	require.NoError(t, admin.Apply(ctx, false))
+
+	details, err := adm.ListTopics(ctx, topic.ImageLiked.Primary)
+	require.NoError(t, err)
+	require.Len(t, details[topic.ImageLiked.Primary].Partitions, int(topic.ImageLiked.Partitions))
+}
+
+func TestTopicAdminImpl_Apply_Fail_MissingPartitionsNotAllowed(t *testing.T) {
+	admin, adm := newTopicAdmin(t, topic.ImageLiked.Primary)
+	ctx := context.Background()
+
+	err := admin.Apply(ctx, false)
+
+	require.ErrorContains(t, err, topic.ImageLiked.Primary)
+	require.ErrorContains(t, err, "-allow-partition-increase")
+	details, err := adm.ListTopics(ctx, topic.ImageLiked.Primary)
+	require.NoError(t, err)
+	require.Len(t, details[topic.ImageLiked.Primary].Partitions, 1)
+
+	// the other topics of the manifest are provisioned anyway
+	require.NoError(t, admin.Check(ctx))
+}
+
+func TestTopicAdminImpl_Check_Fail_ReportsMissingTopics(t *testing.T) {
+	admin, _ := newTopicAdmin(t, topic.ImageLiked.Primary)
+
+	err := admin.Check(context.Background())
+
+	require.ErrorContains(t, err, topic.ImageLiked.DLQ())
+	require.NotContains(t, err.Error(), topic.ImageLiked.Primary+",")
+}
@@ -2,16 +2,20 @@ package messaging
 
 import (
 	"context"
-	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -19,6 +23,7 @@ import (
 
 type UserProducer interface {
 	SendUserFollowed(ctx context.Context, db *gorm.DB, event *dto.UserFollowedEvent) error
+	SendUserUnfollowed(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error
 }
 
 var _ UserProducer = &UserProducerImpl{}
@@ -36,29 +41,42 @@ func NewUserProducer(cfg *config.Config, outboxRepository repository.OutboxRepos
 }
 
 func (p *UserProducerImpl) SendUserFollowed(ctx context.Context, db *gorm.DB, event *dto.UserFollowedEvent) error {
-	err := p.send(ctx, db, topic.UserFollowed, event)
+	err := p.send(ctx, db, topic.UserFollowed, eventschema.UserFollowed, strconv.FormatInt(event.FollowingID, 10), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*UserProducerImpl).SendUserFollowed")
 	}
 	return nil
 }
 
-func (p *UserProducerImpl) send(ctx context.Context, db *gorm.DB, topicName topic.Topic, event any) error {
+func (p *UserProducerImpl) SendUserUnfollowed(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+	err := p.send(ctx, db, topic.UserUnfollowed, eventschema.UserUnfollowed, strconv.FormatInt(event.FollowingID, 10), event)
+	if err != nil {
+		return errkit.AddFuncName(err, "messaging.(*UserProducerImpl).SendUserUnfollowed")
+	}
+	return nil
+}
+
+func (p *UserProducerImpl) send(ctx context.Context, db *gorm.DB, topicName topic.Topic, schema eventkit.Schema, key string, event any) error {
 	if !p.Cfg.GetKafkaProducerEnabled() {
 		logkit.Logger.WithContext(ctx).Warn("Kafka producer is disabled")
 		return nil
 	}
 
-	value, err := json.Marshal(event)
+	id := uuid.New().String()
+	codec := codecFor(p.Cfg, topicName)
+	value, err := schema.Marshal(codec, id, p.Cfg.GetAppName(), key, time.Now(), event)
 	if err != nil {
 		return errkit.AddFuncName(err, "messaging.(*UserProducerImpl).send")
 	}
 
 	outbox := entity.Outbox{
-		Topic:        topicName.Primary,
-		Payload:      value,
-		TraceContext: telemetry.InjectTraceContext(ctx),
-		Status:       entity.OutboxStatusPending,
+		Topic:          topicName.Primary,
+		Key:            key,
+		Payload:        value,
+		ContentType:    codec.ContentType(),
+		TraceContext:   telemetry.InjectTraceContext(ctx),
+		IdempotencyKey: id,
+		Status:         entity.OutboxStatusPending,
 	}
 
 	err = p.OutboxRepository.Insert(ctx, db, &outbox)
@@ -66,7 +84,7 @@ func (p *UserProducerImpl) send(ctx context.Context, db *gorm.DB, topicName topi
 		return errkit.AddFuncName(err, "messaging.(*UserProducerImpl).send")
 	}
 
-	logkit.Logger.WithContext(ctx).WithField("topic", topicName.Primary).Debug("outbox record inserted")
+	logkit.Logger.WithContext(ctx).WithField("topic", topicName.Primary).WithField("key", key).Debug("outbox record inserted")
 
 	return nil
 }
@@ -36,3 +36,18 @@ func (p *UserProducerMwLogger) SendUserFollowed(ctx context.Context, db *gorm.DB
 
 	return err
 }
+
+func (p *UserProducerMwLogger) SendUserUnfollowed(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := p.Next.SendUserUnfollowed(ctx, db, event)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"event": event,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
@@ -14,6 +15,10 @@ import (
 
 type CommentRepository interface {
 	Create(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
+	Update(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
+	Delete(ctx context.Context, db *gorm.DB, comment *entity.Comment) error
+	DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error
+	FindByID(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error
 	FindByImageID(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error
 }
 
@@ -37,6 +42,47 @@ func (r *CommentRepositoryImpl) Create(ctx context.Context, db *gorm.DB, comment
 	return nil
 }
 
+func (r *CommentRepositoryImpl) Update(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	result := db.WithContext(ctx).Model(comment).Update(column.Comment.Str(), comment.Comment)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*CommentRepositoryImpl).Update")
+	}
+	return nil
+}
+
+func (r *CommentRepositoryImpl) Delete(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	result := db.WithContext(ctx).Delete(comment)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*CommentRepositoryImpl).Delete")
+	}
+	return nil
+}
+
+func (r *CommentRepositoryImpl) FindByID(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+	err := db.WithContext(ctx).Where(column.ID.Eq(id)).Take(comment).Error
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusNotFound)
+		return errkit.AddFuncName(err, "repository.(*CommentRepositoryImpl).FindByID")
+	}
+	return nil
+}
+
+func (r *CommentRepositoryImpl) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	err := db.WithContext(ctx).Where(column.ImageID.Eq(imageID)).Delete(&entity.Comment{}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*CommentRepositoryImpl).DeleteByImageID")
+	}
+	return nil
+}
+
 func (r *CommentRepositoryImpl) FindByImageID(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error {
 	err := db.WithContext(ctx).Where(column.ImageID.Eq(imageID)).Find(commentList).Error
 	if err != nil {
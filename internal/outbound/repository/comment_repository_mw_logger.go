@@ -40,6 +40,58 @@ func (r *CommentRepositoryMwLogger) Create(ctx context.Context, db *gorm.DB, com
 	return err
 }
 
+func (r *CommentRepositoryMwLogger) Update(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Update(ctx, db, comment)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"comment": comment,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *CommentRepositoryMwLogger) Delete(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Delete(ctx, db, comment)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"comment": comment,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *CommentRepositoryMwLogger) FindByID(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.FindByID(ctx, db, comment, id)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"comment": comment,
+		"id":      id,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (r *CommentRepositoryMwLogger) FindByImageID(ctx context.Context, db *gorm.DB, commentList *entity.CommentList, imageID int64) error {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -57,3 +109,20 @@ func (r *CommentRepositoryMwLogger) FindByImageID(ctx context.Context, db *gorm.
 
 	return err
 }
+
+func (r *CommentRepositoryMwLogger) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.DeleteByImageID(ctx, db, imageID)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"imageID": imageID,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
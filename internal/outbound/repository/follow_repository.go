@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
@@ -14,7 +16,9 @@ import (
 
 type FollowRepository interface {
 	Create(ctx context.Context, db *gorm.DB, follow *entity.Follow) error
+	Delete(ctx context.Context, db *gorm.DB, follow *entity.Follow) error
 	FindByFollowingID(ctx context.Context, db *gorm.DB, followList *entity.FollowList, followingID int64) error
+	FindByFollowerIDAndFollowingID(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error
 }
 
 var _ FollowRepository = &FollowRepositoryImpl{}
@@ -32,11 +36,31 @@ func NewFollowRepository(cfg *config.Config) *FollowRepositoryImpl {
 func (r *FollowRepositoryImpl) Create(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
 	err := db.WithContext(ctx).Create(follow).Error
 	if err != nil {
+		switch {
+		// a user follows another at most once, see idx_follows_follower_id_following_id_active
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			err = errkit.SetCode(err, http.StatusConflict)
+		// the followed user does not exist
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			err = errkit.SetCode(err, http.StatusNotFound)
+		}
 		return errkit.AddFuncName(err, "repository.(*FollowRepositoryImpl).Create")
 	}
 	return nil
 }
 
+func (r *FollowRepositoryImpl) Delete(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+	result := db.WithContext(ctx).Delete(follow)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*FollowRepositoryImpl).Delete")
+	}
+	return nil
+}
+
 func (r *FollowRepositoryImpl) FindByFollowingID(ctx context.Context, db *gorm.DB, followList *entity.FollowList, followingID int64) error {
 	err := db.WithContext(ctx).Where(column.FollowingID.Eq(followingID)).Find(followList).Error
 	if err != nil {
@@ -44,3 +68,12 @@ func (r *FollowRepositoryImpl) FindByFollowingID(ctx context.Context, db *gorm.D
 	}
 	return nil
 }
+
+func (r *FollowRepositoryImpl) FindByFollowerIDAndFollowingID(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+	err := db.WithContext(ctx).Where(column.FollowerID.Eq(followerID)).Where(column.FollowingID.Eq(followingID)).Take(follow).Error
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusNotFound)
+		return errkit.AddFuncName(err, "repository.(*FollowRepositoryImpl).FindByFollowerIDAndFollowingID")
+	}
+	return nil
+}
@@ -57,3 +57,39 @@ func (r *FollowRepositoryMwLogger) FindByFollowingID(ctx context.Context, db *go
 
 	return err
 }
+
+func (r *FollowRepositoryMwLogger) Delete(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Delete(ctx, db, follow)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"follow": follow,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *FollowRepositoryMwLogger) FindByFollowerIDAndFollowingID(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.FindByFollowerIDAndFollowingID(ctx, db, follow, followerID, followingID)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"follow":      follow,
+		"followerID":  followerID,
+		"followingID": followingID,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
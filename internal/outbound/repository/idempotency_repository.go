@@ -14,8 +14,9 @@ import (
 //go:generate moq -out=../../mock/MockRepositoryIdempotency.go -pkg=mock . IdempotencyRepository
 
 type IdempotencyRepository interface {
-	InsertIfNotExists(ctx context.Context, db *gorm.DB, key, topic string, partition int32, offset int64) (bool, error)
+	InsertIfNotExists(ctx context.Context, db *gorm.DB, consumerGroup, key, topic string, partition int32, offset int64) (bool, error)
 	DeleteOlderThan(ctx context.Context, db *gorm.DB, age time.Duration) (int64, error)
+	Delete(ctx context.Context, db *gorm.DB, consumerGroup, key string) error
 }
 
 var _ IdempotencyRepository = &IdempotencyRepositoryImpl{}
@@ -30,8 +31,9 @@ func NewIdempotencyRepository(cfg *config.Config) *IdempotencyRepositoryImpl {
 	}
 }
 
-func (r *IdempotencyRepositoryImpl) InsertIfNotExists(ctx context.Context, db *gorm.DB, key, topic string, partition int32, offset int64) (bool, error) {
+func (r *IdempotencyRepositoryImpl) InsertIfNotExists(ctx context.Context, db *gorm.DB, consumerGroup, key, topic string, partition int32, offset int64) (bool, error) {
 	record := entity.MessageIdempotency{
+		ConsumerGroup:  consumerGroup,
 		IdempotencyKey: key,
 		Topic:          topic,
 		Partition:      partition,
@@ -60,3 +62,14 @@ func (r *IdempotencyRepositoryImpl) DeleteOlderThan(ctx context.Context, db *gor
 
 	return result.RowsAffected, nil
 }
+
+func (r *IdempotencyRepositoryImpl) Delete(ctx context.Context, db *gorm.DB, consumerGroup, key string) error {
+	err := db.WithContext(ctx).
+		Where("consumer_group = ?", consumerGroup).
+		Where("idempotency_key = ?", key).
+		Delete(&entity.MessageIdempotency{}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*IdempotencyRepositoryImpl).Delete")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"gorm.io/gorm"
+)
+
+var _ IdempotencyRepository = &IdempotencyRepositoryMemory{}
+
+// idempotencyMemoryKey is the primary key of message_idempotency.
+type idempotencyMemoryKey struct {
+	consumerGroup string
+	key           string
+}
+
+// IdempotencyRepositoryMemory keeps idempotency keys in memory and ignores db, so the
+// idempotency middleware runs without Postgres in tests.
+type IdempotencyRepositoryMemory struct {
+	mu      sync.Mutex
+	records map[idempotencyMemoryKey]entity.MessageIdempotency
+}
+
+func NewIdempotencyRepositoryMemory() *IdempotencyRepositoryMemory {
+	return &IdempotencyRepositoryMemory{
+		records: map[idempotencyMemoryKey]entity.MessageIdempotency{},
+	}
+}
+
+func (r *IdempotencyRepositoryMemory) InsertIfNotExists(ctx context.Context, db *gorm.DB, consumerGroup, key, topic string, partition int32, offset int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	memoryKey := idempotencyMemoryKey{consumerGroup: consumerGroup, key: key}
+	if _, ok := r.records[memoryKey]; ok {
+		return false, nil
+	}
+
+	r.records[memoryKey] = entity.MessageIdempotency{
+		ConsumerGroup:  consumerGroup,
+		IdempotencyKey: key,
+		Topic:          topic,
+		Partition:      partition,
+		RecordOffset:   offset,
+		ProcessedAt:    time.Now(),
+	}
+	return true, nil
+}
+
+func (r *IdempotencyRepositoryMemory) DeleteOlderThan(ctx context.Context, db *gorm.DB, age time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cutoff := time.Now().Add(-age)
+	var deleted int64
+	for memoryKey, record := range r.records {
+		if record.ProcessedAt.Before(cutoff) {
+			delete(r.records, memoryKey)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (r *IdempotencyRepositoryMemory) Delete(ctx context.Context, db *gorm.DB, consumerGroup, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.records, idempotencyMemoryKey{consumerGroup: consumerGroup, key: key})
+	return nil
+}
+
+// List returns every claimed key, for assertions in tests.
+func (r *IdempotencyRepositoryMemory) List() []entity.MessageIdempotency {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]entity.MessageIdempotency, 0, len(r.records))
+	for _, record := range r.records {
+		list = append(list, record)
+	}
+	return list
+}
@@ -23,19 +23,20 @@ func NewIdempotencyRepositoryMwLogger(next IdempotencyRepository) *IdempotencyRe
 	}
 }
 
-func (r *IdempotencyRepositoryMwLogger) InsertIfNotExists(ctx context.Context, db *gorm.DB, key, topic string, partition int32, offset int64) (bool, error) {
+func (r *IdempotencyRepositoryMwLogger) InsertIfNotExists(ctx context.Context, db *gorm.DB, consumerGroup, key, topic string, partition int32, offset int64) (bool, error) {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
 
 	var isNew bool
 	err := retrykit.DBRetry(ctx, func() error {
 		var innerErr error
-		isNew, innerErr = r.Next.InsertIfNotExists(ctx, db, key, topic, partition, offset)
+		isNew, innerErr = r.Next.InsertIfNotExists(ctx, db, consumerGroup, key, topic, partition, offset)
 		return innerErr
 	})
 	telemetry.RecordError(span, err)
 
 	fields := logrus.Fields{
+		"consumer_group":  consumerGroup,
 		"idempotency_key": key,
 		"topic":           topic,
 		"partition":       partition,
@@ -67,3 +68,21 @@ func (r *IdempotencyRepositoryMwLogger) DeleteOlderThan(ctx context.Context, db
 
 	return deleted, err
 }
+
+func (r *IdempotencyRepositoryMwLogger) Delete(ctx context.Context, db *gorm.DB, consumerGroup, key string) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Delete(ctx, db, consumerGroup, key)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"consumer_group":  consumerGroup,
+		"idempotency_key": key,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
@@ -16,6 +16,8 @@ import (
 
 type ImageRepository interface {
 	Create(ctx context.Context, db *gorm.DB, image *entity.Image) error
+	UpdateCaption(ctx context.Context, db *gorm.DB, image *entity.Image) error
+	Delete(ctx context.Context, db *gorm.DB, image *entity.Image) error
 	FindByID(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error
 	IncrementCommentCountByID(ctx context.Context, db *gorm.DB, id int64, count int) error
 	IncrementLikeCountByID(ctx context.Context, db *gorm.DB, id int64, count int) error
@@ -41,6 +43,32 @@ func (r *ImageRepositoryImpl) Create(ctx context.Context, db *gorm.DB, image *en
 	return nil
 }
 
+// UpdateCaption updates only the caption, the counters are updated concurrently by the
+// batch consumers and must not be overwritten with the ones loaded with the image.
+func (r *ImageRepositoryImpl) UpdateCaption(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	result := db.WithContext(ctx).Model(image).Update(column.Caption.Str(), image.Caption)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*ImageRepositoryImpl).UpdateCaption")
+	}
+	return nil
+}
+
+func (r *ImageRepositoryImpl) Delete(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	result := db.WithContext(ctx).Delete(image)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*ImageRepositoryImpl).Delete")
+	}
+	return nil
+}
+
 func (r *ImageRepositoryImpl) FindByID(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
 	err := db.WithContext(ctx).Where(column.ID.Eq(id)).Take(image).Error
 	if err != nil {
@@ -63,6 +91,10 @@ func (r *ImageRepositoryImpl) IncrementCommentCountByID(ctx context.Context, db
 	return nil
 }
 
+// IncrementLikeCountByID adds count, negative for unlikes, to the like count. Likes and
+// unlikes are netted per batch, but the two topics are not ordered against each other, so an
+// unlike may still be applied a batch before its like and the count is briefly negative. It
+// is not floored, which would drop that unlike for good, the response shows it as 0 instead.
 func (r *ImageRepositoryImpl) IncrementLikeCountByID(ctx context.Context, db *gorm.DB, id int64, count int) error {
 	err := db.WithContext(ctx).
 		Table(table.Image).
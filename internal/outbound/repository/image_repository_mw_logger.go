@@ -93,3 +93,37 @@ func (r *ImageRepositoryMwLogger) IncrementLikeCountByID(ctx context.Context, db
 
 	return err
 }
+
+func (r *ImageRepositoryMwLogger) UpdateCaption(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.UpdateCaption(ctx, db, image)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"image": image,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *ImageRepositoryMwLogger) Delete(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Delete(ctx, db, image)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"image": image,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
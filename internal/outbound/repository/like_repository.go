@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
@@ -14,7 +16,10 @@ import (
 
 type LikeRepository interface {
 	Create(ctx context.Context, db *gorm.DB, like *entity.Like) error
+	Delete(ctx context.Context, db *gorm.DB, like *entity.Like) error
+	DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error
 	FindByImageID(ctx context.Context, db *gorm.DB, likeList *entity.LikeList, imageID int64) error
+	FindByUserIDAndImageID(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error
 }
 
 var _ LikeRepository = &LikeRepositoryImpl{}
@@ -32,11 +37,35 @@ func NewLikeRepository(cfg *config.Config) *LikeRepositoryImpl {
 func (r *LikeRepositoryImpl) Create(ctx context.Context, db *gorm.DB, like *entity.Like) error {
 	err := db.WithContext(ctx).Create(like).Error
 	if err != nil {
+		// a user likes an image at most once, see idx_likes_user_id_image_id_active
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			err = errkit.SetCode(err, http.StatusConflict)
+		}
 		return errkit.AddFuncName(err, "repository.(*LikeRepositoryImpl).Create")
 	}
 	return nil
 }
 
+func (r *LikeRepositoryImpl) Delete(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+	result := db.WithContext(ctx).Delete(like)
+	err := result.Error
+	if err == nil && result.RowsAffected == 0 {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*LikeRepositoryImpl).Delete")
+	}
+	return nil
+}
+
+func (r *LikeRepositoryImpl) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	err := db.WithContext(ctx).Where(column.ImageID.Eq(imageID)).Delete(&entity.Like{}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*LikeRepositoryImpl).DeleteByImageID")
+	}
+	return nil
+}
+
 func (r *LikeRepositoryImpl) FindByImageID(ctx context.Context, db *gorm.DB, likeList *entity.LikeList, imageID int64) error {
 	err := db.WithContext(ctx).Where(column.ImageID.Eq(imageID)).Find(likeList).Error
 	if err != nil {
@@ -44,3 +73,12 @@ func (r *LikeRepositoryImpl) FindByImageID(ctx context.Context, db *gorm.DB, lik
 	}
 	return nil
 }
+
+func (r *LikeRepositoryImpl) FindByUserIDAndImageID(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+	err := db.WithContext(ctx).Where(column.UserID.Eq(userID)).Where(column.ImageID.Eq(imageID)).Take(like).Error
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusNotFound)
+		return errkit.AddFuncName(err, "repository.(*LikeRepositoryImpl).FindByUserIDAndImageID")
+	}
+	return nil
+}
@@ -57,3 +57,56 @@ func (r *LikeRepositoryMwLogger) FindByImageID(ctx context.Context, db *gorm.DB,
 
 	return err
 }
+
+func (r *LikeRepositoryMwLogger) Delete(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.Delete(ctx, db, like)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"like": like,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *LikeRepositoryMwLogger) FindByUserIDAndImageID(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.FindByUserIDAndImageID(ctx, db, like, userID, imageID)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"like":    like,
+		"userID":  userID,
+		"imageID": imageID,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *LikeRepositoryMwLogger) DeleteByImageID(ctx context.Context, db *gorm.DB, imageID int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.DeleteByImageID(ctx, db, imageID)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"imageID": imageID,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeRepositoryImpl_Delete_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec(`UPDATE "likes" SET "deleted_at"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mockDB.ExpectCommit()
+
+	r := repository.NewLikeRepository(config.NewConfig())
+	err := r.Delete(context.Background(), gormDB, &entity.Like{ID: 1})
+
+	require.Nil(t, err)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+// Unlike relies on this to decrement like_count only once when two requests race to delete
+// the same like.
+func TestLikeRepositoryImpl_Delete_Fail_AlreadyDeleted(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec(`UPDATE "likes" SET "deleted_at"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mockDB.ExpectCommit()
+
+	r := repository.NewLikeRepository(config.NewConfig())
+	err := r.Delete(context.Background(), gormDB, &entity.Like{ID: 1})
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusNotFound, errkit.GetHTTPError(err).HTTPCode)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
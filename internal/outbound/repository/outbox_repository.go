@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
@@ -17,6 +18,11 @@ type OutboxRepository interface {
 	Insert(ctx context.Context, db *gorm.DB, outbox *entity.Outbox) error
 	FindPending(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, limit int) error
 	MarkProduced(ctx context.Context, db *gorm.DB, ids []int64) error
+	MarkRetry(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error
+	MarkFailed(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error
+	FindOlderThan(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error
+	InsertArchive(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error
+	DeleteByIDs(ctx context.Context, db *gorm.DB, ids []int64) error
 }
 
 var _ OutboxRepository = &OutboxRepositoryImpl{}
@@ -43,6 +49,7 @@ func (r *OutboxRepositoryImpl) FindPending(ctx context.Context, db *gorm.DB, out
 	err := db.WithContext(ctx).
 		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
 		Where(column.Status.Eq(entity.OutboxStatusPending)).
+		Where(column.NextAttemptAt.Lte(time.Now())).
 		Order(column.CreatedAt.Str()).
 		Limit(limit).
 		Find(outboxes).Error
@@ -62,3 +69,68 @@ func (r *OutboxRepositoryImpl) MarkProduced(ctx context.Context, db *gorm.DB, id
 	}
 	return nil
 }
+
+func (r *OutboxRepositoryImpl) MarkRetry(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+	err := db.WithContext(ctx).
+		Model(&entity.Outbox{}).
+		Where(column.ID.Eq(id)).
+		Updates(map[string]any{
+			column.Attempts.Str():      attempts,
+			column.LastError.Str():     lastError,
+			column.NextAttemptAt.Str(): nextAttemptAt,
+		}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*OutboxRepositoryImpl).MarkRetry")
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+	err := db.WithContext(ctx).
+		Model(&entity.Outbox{}).
+		Where(column.ID.Eq(id)).
+		Updates(map[string]any{
+			column.Status.Str():    entity.OutboxStatusFailed,
+			column.Attempts.Str():  attempts,
+			column.LastError.Str(): lastError,
+		}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*OutboxRepositoryImpl).MarkFailed")
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) FindOlderThan(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+	cutoff := time.Now().Add(-age)
+
+	err := db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where(column.Status.Eq(status)).
+		Where(column.UpdatedAt.Lt(cutoff)).
+		Order(column.UpdatedAt.Str()).
+		Limit(limit).
+		Find(outboxes).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*OutboxRepositoryImpl).FindOlderThan")
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) InsertArchive(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+	err := db.WithContext(ctx).Create(archives).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*OutboxRepositoryImpl).InsertArchive")
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) DeleteByIDs(ctx context.Context, db *gorm.DB, ids []int64) error {
+	err := db.WithContext(ctx).
+		Unscoped().
+		Where(column.ID.In(ids)).
+		Delete(&entity.Outbox{}).Error
+	if err != nil {
+		return errkit.AddFuncName(err, "repository.(*OutboxRepositoryImpl).DeleteByIDs")
+	}
+	return nil
+}
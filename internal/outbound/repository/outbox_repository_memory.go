@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"gorm.io/gorm"
+)
+
+var _ OutboxRepository = &OutboxRepositoryMemory{}
+
+// OutboxRepositoryMemory keeps outboxes in memory and ignores db, so the outbox producer
+// runs without Postgres in tests. Rows are not locked, run one outbox producer at a time.
+type OutboxRepositoryMemory struct {
+	mu       sync.Mutex
+	nextID   int64
+	outboxes map[int64]entity.Outbox
+	archives entity.OutboxArchiveList
+}
+
+func NewOutboxRepositoryMemory() *OutboxRepositoryMemory {
+	return &OutboxRepositoryMemory{
+		outboxes: map[int64]entity.Outbox{},
+	}
+}
+
+func (r *OutboxRepositoryMemory) Insert(ctx context.Context, db *gorm.DB, outbox *entity.Outbox) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	now := time.Now()
+	outbox.ID = r.nextID
+	outbox.NextAttemptAt = now
+	outbox.CreatedAt = now
+	outbox.UpdatedAt = now
+	r.outboxes[outbox.ID] = *outbox
+	return nil
+}
+
+// find returns the outboxes matching keep ordered by less, at most limit of them.
+func (r *OutboxRepositoryMemory) find(keep func(entity.Outbox) bool, less func(a, b entity.Outbox) bool, limit int) entity.OutboxList {
+	found := entity.OutboxList{}
+	for _, outbox := range r.outboxes {
+		if keep(outbox) {
+			found = append(found, outbox)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return less(found[i], found[j]) })
+	if len(found) > limit {
+		found = found[:limit]
+	}
+	return found
+}
+
+func (r *OutboxRepositoryMemory) FindPending(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, limit int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	*outboxes = r.find(
+		func(o entity.Outbox) bool {
+			return o.Status == entity.OutboxStatusPending && !o.NextAttemptAt.After(now)
+		},
+		func(a, b entity.Outbox) bool { return a.ID < b.ID },
+		limit,
+	)
+	return nil
+}
+
+// update applies fn to the outboxes of ids and bumps their UpdatedAt.
+func (r *OutboxRepositoryMemory) update(ids []int64, fn func(*entity.Outbox)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		outbox, ok := r.outboxes[id]
+		if !ok {
+			continue
+		}
+		fn(&outbox)
+		outbox.UpdatedAt = time.Now()
+		r.outboxes[id] = outbox
+	}
+}
+
+func (r *OutboxRepositoryMemory) MarkProduced(ctx context.Context, db *gorm.DB, ids []int64) error {
+	r.update(ids, func(o *entity.Outbox) {
+		o.Status = entity.OutboxStatusProduced
+	})
+	return nil
+}
+
+func (r *OutboxRepositoryMemory) MarkRetry(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+	r.update([]int64{id}, func(o *entity.Outbox) {
+		o.Attempts = attempts
+		o.LastError = lastError
+		o.NextAttemptAt = nextAttemptAt
+	})
+	return nil
+}
+
+func (r *OutboxRepositoryMemory) MarkFailed(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+	r.update([]int64{id}, func(o *entity.Outbox) {
+		o.Status = entity.OutboxStatusFailed
+		o.Attempts = attempts
+		o.LastError = lastError
+	})
+	return nil
+}
+
+func (r *OutboxRepositoryMemory) FindOlderThan(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cutoff := time.Now().Add(-age)
+	*outboxes = r.find(
+		func(o entity.Outbox) bool {
+			return o.Status == status && o.UpdatedAt.Before(cutoff)
+		},
+		func(a, b entity.Outbox) bool { return a.UpdatedAt.Before(b.UpdatedAt) },
+		limit,
+	)
+	return nil
+}
+
+func (r *OutboxRepositoryMemory) InsertArchive(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.archives = append(r.archives, *archives...)
+	return nil
+}
+
+func (r *OutboxRepositoryMemory) DeleteByIDs(ctx context.Context, db *gorm.DB, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		delete(r.outboxes, id)
+	}
+	return nil
+}
+
+// List returns every outbox ordered by id, for assertions in tests.
+func (r *OutboxRepositoryMemory) List() entity.OutboxList {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.find(
+		func(entity.Outbox) bool { return true },
+		func(a, b entity.Outbox) bool { return a.ID < b.ID },
+		len(r.outboxes),
+	)
+}
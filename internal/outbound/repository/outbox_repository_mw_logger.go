@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
@@ -33,8 +34,10 @@ func (r *OutboxRepositoryMwLogger) Insert(ctx context.Context, db *gorm.DB, outb
 	telemetry.RecordError(span, err)
 
 	fields := logrus.Fields{
-		"topic":  outbox.Topic,
-		"status": outbox.Status,
+		"topic":           outbox.Topic,
+		"key":             outbox.Key,
+		"status":          outbox.Status,
+		"idempotency_key": outbox.IdempotencyKey,
 	}
 	logkit.LogMw(ctx, fields, err)
 
@@ -74,3 +77,95 @@ func (r *OutboxRepositoryMwLogger) MarkProduced(ctx context.Context, db *gorm.DB
 
 	return err
 }
+
+func (r *OutboxRepositoryMwLogger) MarkRetry(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.MarkRetry(ctx, db, id, attempts, lastError, nextAttemptAt)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"id":              id,
+		"attempts":        attempts,
+		"last_error":      lastError,
+		"next_attempt_at": nextAttemptAt,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *OutboxRepositoryMwLogger) MarkFailed(ctx context.Context, db *gorm.DB, id int64, attempts int, lastError string) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.MarkFailed(ctx, db, id, attempts, lastError)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"id":         id,
+		"attempts":   attempts,
+		"last_error": lastError,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *OutboxRepositoryMwLogger) FindOlderThan(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.FindOlderThan(ctx, db, outboxes, status, age, limit)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"status": status,
+		"age":    age.String(),
+		"limit":  limit,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *OutboxRepositoryMwLogger) InsertArchive(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.InsertArchive(ctx, db, archives)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"count": len(*archives),
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (r *OutboxRepositoryMwLogger) DeleteByIDs(ctx context.Context, db *gorm.DB, ids []int64) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := retrykit.DBRetry(ctx, func() error {
+		return r.Next.DeleteByIDs(ctx, db, ids)
+	})
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"ids": ids,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
@@ -30,6 +30,9 @@ func NewUserStatRepository(cfg *config.Config) *UserStatRepositoryImpl {
 	}
 }
 
+// IncrementFollowerCountByID adds count, negative for unfollows, to the follower count.
+// user.followed and user.unfollowed are netted per batch but not ordered against each other,
+// counts are not floored at 0 for the same reason as ImageRepositoryImpl.IncrementLikeCountByID.
 func (r *UserStatRepositoryImpl) IncrementFollowerCountByID(ctx context.Context, db *gorm.DB, id int64, count int) error {
 	err := db.WithContext(ctx).
 		Table(table.UserStat).
@@ -5,18 +5,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/indexname"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
 )
 
 //go:generate moq -out=../../mock/MockSearchImage.go -pkg=mock . ImageSearch
 
 type ImageSearch interface {
 	IndexImage(ctx context.Context, document *dto.ImageDocument) error
+	DeleteImage(ctx context.Context, id int64, deletedAt time.Time) error
+	DeleteLegacyImages(ctx context.Context) (deleted int, err error)
 }
 
 type ImageSearchImpl struct {
@@ -31,18 +37,34 @@ func NewImageSearch(client *elasticsearch.Client) ImageSearch {
 	}
 }
 
+// IndexImage creates or replaces the document of the image, indexed under the image id.
+// The document is versioned by its updated at, so when the events of an image are
+// consumed out of order, e.g. image.updated before image.uploaded, the older one is
+// ignored instead of overwriting the newer one.
 func (i *ImageSearchImpl) IndexImage(ctx context.Context, document *dto.ImageDocument) error {
 	jsonByte, err := json.Marshal(document)
 	if err != nil {
 		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).IndexImage")
 	}
 
-	res, err := i.client.Index(indexname.Images, bytes.NewReader(jsonByte))
+	res, err := i.client.Index(
+		indexname.Images,
+		bytes.NewReader(jsonByte),
+		i.client.Index.WithContext(ctx),
+		i.client.Index.WithDocumentID(strconv.FormatInt(document.ID, 10)),
+		i.client.Index.WithVersion(int(document.UpdatedAt.UnixMicro())),
+		i.client.Index.WithVersionType("external"),
+	)
 	if err != nil {
 		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).IndexImage")
 	}
 	defer logkit.LogIfErrForDeferContext(ctx, res.Body.Close)
 
+	// the document is already at this version or a newer one
+	if res.StatusCode == http.StatusConflict {
+		return nil
+	}
+
 	if res.IsError() {
 		err := errors.New(res.String())
 		err = errkit.Wrap(err, "indexing error")
@@ -51,3 +73,202 @@ func (i *ImageSearchImpl) IndexImage(ctx context.Context, document *dto.ImageDoc
 
 	return nil
 }
+
+// DeleteImage deletes the document of the image, versioned by its deleted at like IndexImage
+// versions it by its updated at. The delete leaves a tombstone of that version, so an older
+// event of the image indexed after it, e.g. an image.updated consumed after image.deleted,
+// conflicts instead of bringing the image back, as long as the tombstone is kept
+// (index.gc_deletes, a minute by default). Documents indexed under a generated id before documents were indexed
+// under the image id carry no version and are deleted by query.
+func (i *ImageSearchImpl) DeleteImage(ctx context.Context, id int64, deletedAt time.Time) error {
+	res, err := i.client.Delete(
+		indexname.Images,
+		strconv.FormatInt(id, 10),
+		i.client.Delete.WithContext(ctx),
+		i.client.Delete.WithVersion(int(deletedAt.UnixMicro())),
+		i.client.Delete.WithVersionType("external"),
+	)
+	if err != nil {
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteImage")
+	}
+	defer logkit.LogIfErrForDeferContext(ctx, res.Body.Close)
+
+	// the document was never indexed, its tombstone is written all the same, or a newer one
+	// is already there
+	if res.IsError() && res.StatusCode != http.StatusNotFound && res.StatusCode != http.StatusConflict {
+		err := errors.New(res.String())
+		err = errkit.Wrap(err, "delete error")
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteImage")
+	}
+
+	err = i.deleteByQuery(ctx, map[string]any{
+		"bool": map[string]any{
+			"filter":   map[string]any{"term": map[string]any{"id": id}},
+			"must_not": map[string]any{"ids": map[string]any{"values": []string{strconv.FormatInt(id, 10)}}},
+		},
+	})
+	if err != nil {
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteImage")
+	}
+	return nil
+}
+
+// DeleteLegacyImages moves the documents indexed under a generated id, before documents
+// were indexed under the image id, to the image id, so an image is not found twice. Each is
+// indexed under the image id like IndexImage does first, ignored when a newer one is
+// already there, then deleted. It is run once by cmd/imagesearchcleanup.
+func (i *ImageSearchImpl) DeleteLegacyImages(ctx context.Context) (deleted int, err error) {
+	scrollID := ""
+	defer func() {
+		if scrollID != "" {
+			i.clearScroll(ctx, scrollID)
+		}
+	}()
+
+	for {
+		page, err := i.scrollImages(ctx, scrollID)
+		if err != nil {
+			return deleted, errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteLegacyImages")
+		}
+		scrollID = page.ScrollID
+		if len(page.Hits.Hits) == 0 {
+			return deleted, nil
+		}
+
+		legacyIDs := []string{}
+		for _, hit := range page.Hits.Hits {
+			if hit.ID == strconv.FormatInt(hit.Source.ID, 10) {
+				continue
+			}
+			// a deleted image is not in search, its legacy document is only deleted
+			if !hit.Source.DeletedAt.Valid {
+				err := i.IndexImage(ctx, &hit.Source)
+				if err != nil {
+					return deleted, errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteLegacyImages")
+				}
+			}
+			legacyIDs = append(legacyIDs, hit.ID)
+		}
+		if len(legacyIDs) == 0 {
+			continue
+		}
+
+		err = i.deleteByQuery(ctx, map[string]any{
+			"ids": map[string]any{"values": legacyIDs},
+		})
+		if err != nil {
+			return deleted, errkit.AddFuncName(err, "search.(*ImageSearchImpl).DeleteLegacyImages")
+		}
+		deleted += len(legacyIDs)
+	}
+}
+
+const (
+	imagesScrollPageSize  = 1000
+	imagesScrollKeepAlive = time.Minute
+)
+
+type imagesScrollPage struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			ID     string            `json:"_id"`
+			Source dto.ImageDocument `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// scrollImages reads the next page of the image documents, the first page when scrollID is empty.
+// The index does not exist until the first image is indexed, so its absence is an empty page.
+func (i *ImageSearchImpl) scrollImages(ctx context.Context, scrollID string) (imagesScrollPage, error) {
+	page := imagesScrollPage{}
+
+	var res *esapi.Response
+	var err error
+	if scrollID == "" {
+		jsonByte, err := json.Marshal(map[string]any{
+			"sort": []string{"_doc"},
+		})
+		if err != nil {
+			return page, errkit.AddFuncName(err, "search.(*ImageSearchImpl).scrollImages")
+		}
+
+		res, err = i.client.Search(
+			i.client.Search.WithContext(ctx),
+			i.client.Search.WithIndex(indexname.Images),
+			i.client.Search.WithBody(bytes.NewReader(jsonByte)),
+			i.client.Search.WithSize(imagesScrollPageSize),
+			i.client.Search.WithScroll(imagesScrollKeepAlive),
+		)
+	} else {
+		res, err = i.client.Scroll(
+			i.client.Scroll.WithContext(ctx),
+			i.client.Scroll.WithScrollID(scrollID),
+			i.client.Scroll.WithScroll(imagesScrollKeepAlive),
+		)
+	}
+	if err != nil {
+		return page, errkit.AddFuncName(err, "search.(*ImageSearchImpl).scrollImages")
+	}
+	defer logkit.LogIfErrForDeferContext(ctx, res.Body.Close)
+
+	if res.StatusCode == http.StatusNotFound {
+		return page, nil
+	}
+
+	if res.IsError() {
+		err := errors.New(res.String())
+		err = errkit.Wrap(err, "scroll error")
+		return page, errkit.AddFuncName(err, "search.(*ImageSearchImpl).scrollImages")
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&page)
+	if err != nil {
+		return page, errkit.AddFuncName(err, "search.(*ImageSearchImpl).scrollImages")
+	}
+
+	return page, nil
+}
+
+func (i *ImageSearchImpl) clearScroll(ctx context.Context, scrollID string) {
+	res, err := i.client.ClearScroll(
+		i.client.ClearScroll.WithContext(context.WithoutCancel(ctx)),
+		i.client.ClearScroll.WithScrollID(scrollID),
+	)
+	if err != nil {
+		logkit.Logger.WithContext(ctx).WithError(err).Warn("failed to clear scroll")
+		return
+	}
+	defer logkit.LogIfErrForDeferContext(ctx, res.Body.Close)
+}
+
+func (i *ImageSearchImpl) deleteByQuery(ctx context.Context, query map[string]any) error {
+	jsonByte, err := json.Marshal(map[string]any{"query": query})
+	if err != nil {
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).deleteByQuery")
+	}
+
+	res, err := i.client.DeleteByQuery(
+		[]string{indexname.Images},
+		bytes.NewReader(jsonByte),
+		i.client.DeleteByQuery.WithContext(ctx),
+		i.client.DeleteByQuery.WithConflicts("proceed"),
+	)
+	if err != nil {
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).deleteByQuery")
+	}
+	defer logkit.LogIfErrForDeferContext(ctx, res.Body.Close)
+
+	// the index does not exist until the first image is indexed, so there is nothing to delete
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if res.IsError() {
+		err := errors.New(res.String())
+		err = errkit.Wrap(err, "delete by query error")
+		return errkit.AddFuncName(err, "search.(*ImageSearchImpl).deleteByQuery")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package search
 
 import (
 	"context"
+	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
@@ -35,3 +36,34 @@ func (i *ImageSearchMwLogger) IndexImage(ctx context.Context, document *dto.Imag
 
 	return err
 }
+
+func (i *ImageSearchMwLogger) DeleteImage(ctx context.Context, id int64, deletedAt time.Time) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := i.Next.DeleteImage(ctx, id, deletedAt)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"id":        id,
+		"deletedAt": deletedAt,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (i *ImageSearchMwLogger) DeleteLegacyImages(ctx context.Context) (int, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	deleted, err := i.Next.DeleteLegacyImages(ctx)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"deleted": deleted,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return deleted, err
+}
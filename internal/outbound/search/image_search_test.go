@@ -0,0 +1,250 @@
+package search_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/search"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeDocument struct {
+	Version int64
+	Source  dto.ImageDocument
+	Deleted bool
+}
+
+// fakeImagesIndex is an in-memory images index answering the requests of ImageSearchImpl,
+// with external versioning, delete tombstones and a scroll returning every document at once.
+type fakeImagesIndex struct {
+	mu        sync.Mutex
+	documents map[string]fakeDocument
+}
+
+// newFakeImagesIndex starts a server serving index and returns the image search using it.
+func newFakeImagesIndex(t *testing.T, index *fakeImagesIndex) search.ImageSearch {
+	t.Helper()
+
+	if index.documents == nil {
+		index.documents = map[string]fakeDocument{}
+	}
+	server := httptest.NewServer(http.HandlerFunc(index.serveHTTP))
+	t.Cleanup(server.Close)
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	require.NoError(t, err)
+
+	return search.NewImageSearch(client)
+}
+
+// findable returns the ids of the live documents of the image.
+func (f *fakeImagesIndex) findable(imageID int64) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := []string{}
+	for id, document := range f.documents {
+		if !document.Deleted && document.Source.ID == imageID {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (f *fakeImagesIndex) document(id string) fakeDocument {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.documents[id]
+}
+
+func (f *fakeImagesIndex) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+
+	version, _ := strconv.ParseInt(r.URL.Query().Get("version"), 10, 64)
+	id, isDocument := strings.CutPrefix(r.URL.Path, "/images/_doc/")
+	switch {
+	case isDocument && r.Method == http.MethodPut:
+		existing, ok := f.documents[id]
+		if ok && existing.Version >= version {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"type":"version_conflict_engine_exception"}}`))
+			return
+		}
+		source := dto.ImageDocument{}
+		_ = json.NewDecoder(r.Body).Decode(&source)
+		f.documents[id] = fakeDocument{Version: version, Source: source}
+		_, _ = w.Write([]byte(`{"result":"created"}`))
+	case isDocument && r.Method == http.MethodDelete:
+		existing, ok := f.documents[id]
+		if ok && existing.Version >= version {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"type":"version_conflict_engine_exception"}}`))
+			return
+		}
+		f.documents[id] = fakeDocument{Version: version, Deleted: true}
+		if !ok || existing.Deleted {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"result":"not_found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"deleted"}`))
+	case r.URL.Path == "/images/_search":
+		hits := []map[string]any{}
+		for id, document := range f.documents {
+			if !document.Deleted {
+				hits = append(hits, map[string]any{"_id": id, "_source": document.Source})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"_scroll_id": "scroll-1", "hits": map[string]any{"hits": hits}})
+	case r.URL.Path == "/_search/scroll":
+		_, _ = w.Write([]byte(`{"_scroll_id":"scroll-1","hits":{"hits":[]}}`))
+	case r.URL.Path == "/images/_delete_by_query":
+		body := struct {
+			Query struct {
+				IDs struct {
+					Values []string `json:"values"`
+				} `json:"ids"`
+				Bool struct {
+					Filter struct {
+						Term struct {
+							ID int64 `json:"id"`
+						} `json:"term"`
+					} `json:"filter"`
+					MustNot struct {
+						IDs struct {
+							Values []string `json:"values"`
+						} `json:"ids"`
+					} `json:"must_not"`
+				} `json:"bool"`
+			} `json:"query"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, id := range body.Query.IDs.Values {
+			delete(f.documents, id)
+		}
+		imageID := body.Query.Bool.Filter.Term.ID
+		for id, document := range f.documents {
+			if imageID != 0 && !document.Deleted && document.Source.ID == imageID && !slices.Contains(body.Query.Bool.MustNot.IDs.Values, id) {
+				delete(f.documents, id)
+			}
+		}
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func TestImageSearchImpl_IndexImage_IgnoresOlderVersion(t *testing.T) {
+	index := &fakeImagesIndex{}
+	imageSearch := newFakeImagesIndex(t, index)
+
+	updatedAt := time.UnixMicro(1767225600000000)
+	err := imageSearch.IndexImage(context.Background(), &dto.ImageDocument{ID: 100, Caption: "edited", UpdatedAt: updatedAt})
+	require.NoError(t, err)
+
+	err = imageSearch.IndexImage(context.Background(), &dto.ImageDocument{ID: 100, Caption: "sunrise", UpdatedAt: updatedAt.Add(-time.Minute)})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"100"}, index.findable(100))
+	require.Equal(t, "edited", index.document("100").Source.Caption)
+	require.Equal(t, updatedAt.UnixMicro(), index.document("100").Version)
+}
+
+func TestImageSearchImpl_DeleteImage_StaleIndexStaysDeleted(t *testing.T) {
+	updatedAt := time.UnixMicro(1767225600000000)
+	index := &fakeImagesIndex{documents: map[string]fakeDocument{
+		"100":         {Version: updatedAt.UnixMicro(), Source: dto.ImageDocument{ID: 100, Caption: "sunrise", UpdatedAt: updatedAt}},
+		"generated-1": {Version: 1, Source: dto.ImageDocument{ID: 100, Caption: "sunrise", UpdatedAt: updatedAt}},
+		"101":         {Version: updatedAt.UnixMicro(), Source: dto.ImageDocument{ID: 101, Caption: "sunset", UpdatedAt: updatedAt}},
+	}}
+	imageSearch := newFakeImagesIndex(t, index)
+
+	deletedAt := updatedAt.Add(time.Minute)
+	err := imageSearch.DeleteImage(context.Background(), 100, deletedAt)
+	require.NoError(t, err)
+
+	// e.g. the image.updated of an edit before the delete, consumed after it
+	err = imageSearch.IndexImage(context.Background(), &dto.ImageDocument{ID: 100, Caption: "edited", UpdatedAt: deletedAt.Add(-time.Second)})
+	require.NoError(t, err)
+
+	require.Empty(t, index.findable(100))
+	require.True(t, index.document("100").Deleted)
+	require.Equal(t, deletedAt.UnixMicro(), index.document("100").Version)
+	require.Equal(t, []string{"101"}, index.findable(101))
+}
+
+func TestImageSearchImpl_DeleteImage_NeverIndexed(t *testing.T) {
+	index := &fakeImagesIndex{}
+	imageSearch := newFakeImagesIndex(t, index)
+
+	deletedAt := time.UnixMicro(1767225600000000)
+	err := imageSearch.DeleteImage(context.Background(), 100, deletedAt)
+	require.NoError(t, err)
+
+	// the image.uploaded consumed after the image.deleted
+	err = imageSearch.IndexImage(context.Background(), &dto.ImageDocument{ID: 100, Caption: "sunrise", UpdatedAt: deletedAt.Add(-time.Minute)})
+	require.NoError(t, err)
+
+	require.Empty(t, index.findable(100))
+}
+
+func TestImageSearchImpl_DeleteLegacyImages(t *testing.T) {
+	updatedAt := time.UnixMicro(1767225600000000)
+	index := &fakeImagesIndex{documents: map[string]fakeDocument{
+		// indexed under the image id and under a generated id before
+		"100":         {Version: updatedAt.UnixMicro(), Source: dto.ImageDocument{ID: 100, Caption: "edited", UpdatedAt: updatedAt}},
+		"generated-1": {Version: 1, Source: dto.ImageDocument{ID: 100, Caption: "sunrise", UpdatedAt: updatedAt.Add(-time.Minute)}},
+		// only indexed under a generated id, never edited since
+		"generated-2": {Version: 1, Source: dto.ImageDocument{ID: 101, Caption: "sunset", UpdatedAt: updatedAt}},
+		// deleted image
+		"generated-3": {Version: 1, Source: dto.ImageDocument{ID: 102, DeletedAt: gorm.DeletedAt{Time: updatedAt, Valid: true}}},
+	}}
+	imageSearch := newFakeImagesIndex(t, index)
+
+	deleted, err := imageSearch.DeleteLegacyImages(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 3, deleted)
+
+	require.Equal(t, []string{"100"}, index.findable(100))
+	require.Equal(t, "edited", index.document("100").Source.Caption)
+
+	require.Equal(t, []string{"101"}, index.findable(101))
+	require.Equal(t, "sunset", index.document("101").Source.Caption)
+	require.Equal(t, updatedAt.UnixMicro(), index.document("101").Version)
+
+	require.Empty(t, index.findable(102))
+}
+
+func TestImageSearchImpl_DeleteLegacyImages_IndexNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception"}}`))
+	}))
+	defer server.Close()
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	require.NoError(t, err)
+	imageSearch := search.NewImageSearch(client)
+
+	deleted, err := imageSearch.DeleteLegacyImages(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+}
@@ -3,6 +3,8 @@ package storage
 import (
 	"context"
 	"fmt"
+	neturl "net/url"
+	"strings"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
@@ -15,6 +17,7 @@ import (
 
 type S3Client interface {
 	UploadImage(ctx context.Context, req dto.S3UploadImageRequest) (url string, err error)
+	DeleteImage(ctx context.Context, req dto.S3DeleteImageRequest) error
 	Download(ctx context.Context, req dto.S3DownloadRequest) (dto.S3DownloadResponse, error)
 	DeleteObject(ctx context.Context, req dto.S3DeleteObjectRequest) (dto.S3DeleteObjectResponse, error)
 }
@@ -49,6 +52,30 @@ func (c *S3ClientImpl) UploadImage(ctx context.Context, req dto.S3UploadImageReq
 	return url, nil
 }
 
+// DeleteImage deletes the object of an image uploaded by UploadImage, given the url
+// UploadImage returned. The key is taken from the url path, so images uploaded before the
+// base endpoint changed are deleted too. A url that is not of the image bucket never will
+// be, the error is non-retryable.
+func (c *S3ClientImpl) DeleteImage(ctx context.Context, req dto.S3DeleteImageRequest) error {
+	key := ""
+	imageURL, err := neturl.Parse(req.URL)
+	if err == nil {
+		_, key, _ = strings.Cut(imageURL.Path, "/"+bucketname.Image+"/")
+	}
+	if key == "" {
+		err := fmt.Errorf("image url %s is not an object of bucket %s", req.URL, bucketname.Image)
+		err = errkit.WrapNonRetryable(err)
+		return errkit.AddFuncName(err, "storage.(*S3ClientImpl).DeleteImage")
+	}
+
+	_, err = c.DeleteObject(ctx, dto.S3DeleteObjectRequest{Bucket: bucketname.Image, Key: key})
+	if err != nil {
+		return errkit.AddFuncName(err, "storage.(*S3ClientImpl).DeleteImage")
+	}
+
+	return nil
+}
+
 func (c *S3ClientImpl) Download(ctx context.Context, req dto.S3DownloadRequest) (dto.S3DownloadResponse, error) {
 	// TODO implement hit external rest api
 	return dto.S3DownloadResponse{
@@ -56,8 +83,16 @@ func (c *S3ClientImpl) Download(ctx context.Context, req dto.S3DownloadRequest)
 	}, nil
 }
 
+// DeleteObject deletes the object, deleting an object that does not exist succeeds too.
 func (c *S3ClientImpl) DeleteObject(ctx context.Context, req dto.S3DeleteObjectRequest) (dto.S3DeleteObjectResponse, error) {
-	// TODO implement hit external rest api
+	_, err := c.AWSS3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &req.Bucket,
+		Key:    &req.Key,
+	})
+	if err != nil {
+		return dto.S3DeleteObjectResponse{}, errkit.AddFuncName(err, "storage.(*S3ClientImpl).DeleteObject")
+	}
+
 	return dto.S3DeleteObjectResponse{
 		Deleted: true,
 	}, nil
@@ -37,6 +37,21 @@ func (c *S3ClientMwLogger) UploadImage(ctx context.Context, req dto.S3UploadImag
 	return url, err
 }
 
+func (c *S3ClientMwLogger) DeleteImage(ctx context.Context, req dto.S3DeleteImageRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := c.Next.DeleteImage(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"url": req.URL,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (c *S3ClientMwLogger) Download(ctx context.Context, req dto.S3DownloadRequest) (dto.S3DownloadResponse, error) {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
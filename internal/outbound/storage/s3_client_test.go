@@ -0,0 +1,77 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/storage"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3ClientImpl_DeleteImage(t *testing.T) {
+	for name, c := range map[string]struct {
+		url             string
+		wantDeletedPath string
+	}{
+		"current endpoint": {
+			url:             "http://localhost:9000/image/2026/1.jpg",
+			wantDeletedPath: "/image/2026/1.jpg",
+		},
+		"old endpoint": {
+			url:             "http://minio.old.internal:9000/image/2026/1.jpg",
+			wantDeletedPath: "/image/2026/1.jpg",
+		},
+		"foreign bucket": {
+			url: "http://localhost:9000/avatar/2026/1.jpg",
+		},
+		"malformed url": {
+			url: "http://localhost:9000/image/%zz",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mu := sync.Mutex{}
+			deletedPaths := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				if r.Method == http.MethodDelete {
+					deletedPaths = append(deletedPaths, r.URL.Path)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			cfg := config.NewConfig()
+			cfg.Set(config.AWSBaseEndpoint, "http://localhost:9000")
+			awsS3Client := s3.New(s3.Options{
+				Region:       "us-east-1",
+				BaseEndpoint: aws.String(server.URL),
+				UsePathStyle: true,
+				Credentials:  aws.AnonymousCredentials{},
+				HTTPClient:   server.Client(),
+			})
+			s3Client := storage.NewS3Client(cfg, awsS3Client)
+
+			err := s3Client.DeleteImage(context.Background(), dto.S3DeleteImageRequest{URL: c.url})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if c.wantDeletedPath == "" {
+				require.Error(t, err)
+				require.True(t, errkit.IsNonRetryable(err))
+				require.Empty(t, deletedPaths)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{c.wantDeletedPath}, deletedPaths)
+		})
+	}
+}
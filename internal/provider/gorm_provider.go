@@ -13,17 +13,22 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-func NewDatabase(cfg *config.Config) *gorm.DB {
+func NewDatabaseDSN(cfg *config.Config) string {
 	username := cfg.GetDatabaseUsername()
 	password := cfg.GetDatabasePassword()
 	host := cfg.GetDatabaseHost()
 	port := cfg.GetDatabasePort()
 	database := cfg.GetDatabaseName()
+
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable TimeZone=UTC", host, port, username, password, database)
+}
+
+func NewDatabase(cfg *config.Config) *gorm.DB {
 	idleConnection := cfg.GetDatabasePoolIdle()
 	maxConnection := cfg.GetDatabasePoolMax()
 	maxLifeTimeConnection := cfg.GetDatabasePoolLifetime()
 
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable TimeZone=UTC", host, port, username, password, database)
+	dsn := NewDatabaseDSN(cfg)
 
 	const maxAttempts = 5
 
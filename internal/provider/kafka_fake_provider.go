@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/twmb/franz-go/pkg/kfake"
+)
+
+// NewKafkaFakeCluster starts an in-process Kafka cluster holding every topic of
+// topic.Manifest and points cfg at it, so the producers and consumer loops built from cfg,
+// retry and DLQ routing included, run without a broker. It is meant for tests and local
+// development, see cmd/kafkafake.
+func NewKafkaFakeCluster(cfg *config.Config, opts ...kfake.Opt) *kfake.Cluster {
+	for _, spec := range topic.Manifest(len(cfg.GetKafkaConsumerRetryDelaysSeconds())) {
+		opts = append(opts, kfake.SeedTopics(spec.Partitions, spec.Name))
+	}
+
+	cluster, err := kfake.NewCluster(opts...)
+	errkit.PanicIfErr(err)
+
+	cfg.SetKafkaBootstrapServers(strings.Join(cluster.ListenAddrs(), ","))
+
+	return cluster
+}
@@ -1,25 +1,32 @@
 package provider
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
 	"github.com/twmb/franz-go/plugin/kotel"
 )
 
 func NewKafkaClientProducer(cfg *config.Config) *kgo.Client {
-	brokers := strings.Split(cfg.GetKafkaBootstrapServers(), ",")
-	opts := []kgo.Opt{
-		kgo.SeedBrokers(brokers...),
-		kgo.AllowAutoTopicCreation(),
-		kgo.RequestRetries(20),
+	opts := newKafkaClientOpts(cfg)
+	opts = append(opts, kgo.RequestRetries(cfg.GetKafkaProducerRequestRetries()))
+	if v := cfg.GetKafkaProducerLingerMilliseconds(); v > 0 {
+		opts = append(opts, kgo.ProducerLinger(time.Duration(v)*time.Millisecond))
+	}
+	if v := cfg.GetKafkaProducerBatchMaxBytes(); v > 0 {
+		opts = append(opts, kgo.ProducerBatchMaxBytes(int32(v)))
 	}
-
-	tracer := kotel.NewTracer()
-	opts = append(opts, kgo.WithHooks(tracer))
 
 	client, err := kgo.NewClient(opts...)
 	errkit.PanicIfErr(err)
@@ -27,21 +34,27 @@ func NewKafkaClientProducer(cfg *config.Config) *kgo.Client {
 	return client
 }
 
-func NewKafkaClientConsumerBatch(cfg *config.Config, consumerGroup string, topic string) *kgo.Client {
-	brokers := strings.Split(cfg.GetKafkaBootstrapServers(), ",")
-	const _1MB = 1024 * 1024
-	opts := []kgo.Opt{
-		kgo.SeedBrokers(brokers...),
-		kgo.ConsumerGroup(consumerGroup),
-		kgo.ConsumeTopics(topic),
-		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
-		kgo.DisableAutoCommit(),
-		kgo.FetchMinBytes(_1MB),           // will wait atleast 1 MB before processing
-		kgo.FetchMaxWait(5 * time.Second), // but with interval 5 second
-	}
+func NewKafkaClientConsumerBatch(cfg *config.Config, consumerGroup string, topics ...string) *kgo.Client {
+	opts := newKafkaClientConsumerOpts(cfg, consumerGroup, topics...)
+	// waits for the batch to fill up, but at most fetch max wait
+	opts = append(opts, kgo.FetchMinBytes(int32(cfg.GetKafkaConsumerBatchFetchMinBytes())))
 
-	tracer := kotel.NewTracer()
-	opts = append(opts, kgo.WithHooks(tracer))
+	client, err := kgo.NewClient(opts...)
+	errkit.PanicIfErr(err)
+
+	return client
+}
+
+// NewKafkaClientConsumerSingle returns a consumer whose partitions are processed
+// concurrently. onRevoked is called when partitions are revoked or lost, and must wait for
+// their records in flight before returning.
+func NewKafkaClientConsumerSingle(cfg *config.Config, consumerGroup string, topics []string, onRevoked func(context.Context, *kgo.Client, map[string][]int32)) *kgo.Client {
+	opts := newKafkaClientConsumerOpts(cfg, consumerGroup, topics...)
+	opts = append(opts,
+		kgo.FetchMinBytes(int32(cfg.GetKafkaConsumerFetchMinBytes())),
+		kgo.OnPartitionsRevoked(onRevoked),
+		kgo.OnPartitionsLost(onRevoked),
+	)
 
 	client, err := kgo.NewClient(opts...)
 	errkit.PanicIfErr(err)
@@ -49,22 +62,112 @@ func NewKafkaClientConsumerBatch(cfg *config.Config, consumerGroup string, topic
 	return client
 }
 
-func NewKafkaClientConsumerSingle(cfg *config.Config, consumerGroup string, topics ...string) *kgo.Client {
+// newKafkaClientOpts returns the options every client shares: the brokers, how to
+// authenticate to them and tracing.
+func newKafkaClientOpts(cfg *config.Config) []kgo.Opt {
 	brokers := strings.Split(cfg.GetKafkaBootstrapServers(), ",")
 	opts := []kgo.Opt{
 		kgo.SeedBrokers(brokers...),
-		kgo.ConsumerGroup(consumerGroup),
-		kgo.ConsumeTopics(topics...),
-		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
-		kgo.DisableAutoCommit(),
-		kgo.FetchMaxWait(5 * time.Second),
+	}
+
+	mechanism, err := newKafkaSASLMechanism(cfg)
+	errkit.PanicIfErr(err)
+	if mechanism != nil {
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+
+	tlsConfig, err := newKafkaTLSConfig(cfg)
+	errkit.PanicIfErr(err)
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
 	}
 
 	tracer := kotel.NewTracer()
 	opts = append(opts, kgo.WithHooks(tracer))
 
-	client, err := kgo.NewClient(opts...)
+	return opts
+}
+
+func newKafkaClientConsumerOpts(cfg *config.Config, consumerGroup string, topics ...string) []kgo.Opt {
+	resetOffset, err := newKafkaResetOffset(cfg)
 	errkit.PanicIfErr(err)
 
-	return client
+	opts := newKafkaClientOpts(cfg)
+	opts = append(opts,
+		kgo.ConsumerGroup(consumerGroup),
+		kgo.ConsumeTopics(topics...),
+		kgo.ConsumeResetOffset(resetOffset),
+		kgo.DisableAutoCommit(),
+		// partitions are not revoked between a poll and AllowRebalance, so the offsets
+		// committed for the polled records are of partitions the client still owns
+		kgo.BlockRebalanceOnPoll(),
+		kgo.FetchMaxWait(time.Duration(cfg.GetKafkaConsumerFetchMaxWaitMilliseconds())*time.Millisecond),
+	)
+	if v := cfg.GetKafkaConsumerFetchMaxBytes(); v > 0 {
+		opts = append(opts, kgo.FetchMaxBytes(int32(v)))
+	}
+
+	return opts
+}
+
+func newKafkaResetOffset(cfg *config.Config) (kgo.Offset, error) {
+	switch reset := cfg.GetKafkaAutoOffsetReset(); reset {
+	case "earliest":
+		return kgo.NewOffset().AtStart(), nil
+	case "latest":
+		return kgo.NewOffset().AtEnd(), nil
+	default:
+		return kgo.Offset{}, fmt.Errorf("unknown %s %q, expected earliest or latest", config.KafkaAutoOffsetReset, reset)
+	}
+}
+
+func newKafkaSASLMechanism(cfg *config.Config) (sasl.Mechanism, error) {
+	username := cfg.GetKafkaSASLUsername()
+	password := cfg.GetKafkaSASLPassword()
+
+	switch mechanism := cfg.GetKafkaSASLMechanism(); mechanism {
+	case "":
+		return nil, nil
+	case "PLAIN":
+		return plain.Auth{User: username, Pass: password}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: username, Pass: password}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: username, Pass: password}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q, expected PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512", config.KafkaSASLMechanism, mechanism)
+	}
+}
+
+func newKafkaTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	if !cfg.GetKafkaTLSEnabled() {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.GetKafkaTLSInsecureSkipVerify(),
+	}
+
+	if caFile := cfg.GetKafkaTLSCAFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read kafka tls ca file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("kafka tls ca file %s has no PEM certificate", caFile)
+		}
+	}
+
+	certFile, keyFile := cfg.GetKafkaTLSCertFile(), cfg.GetKafkaTLSKeyFile()
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load kafka tls client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
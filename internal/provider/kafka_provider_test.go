@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKafkaResetOffset(t *testing.T) {
+	for reset, wantErr := range map[string]bool{
+		"":         false,
+		"earliest": false,
+		"latest":   false,
+		"newest":   true,
+	} {
+		t.Run(strconv.Quote(reset), func(t *testing.T) {
+			cfg := config.NewConfig()
+			cfg.Set(config.KafkaAutoOffsetReset, reset)
+
+			_, err := newKafkaResetOffset(cfg)
+			if wantErr {
+				require.ErrorContains(t, err, "unknown kafka.auto.offset.reset")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewKafkaSASLMechanism(t *testing.T) {
+	for mechanism, wantErr := range map[string]bool{
+		"":              false,
+		"PLAIN":         false,
+		"SCRAM-SHA-256": false,
+		"SCRAM-SHA-512": false,
+		"GSSAPI":        true,
+	} {
+		t.Run(strconv.Quote(mechanism), func(t *testing.T) {
+			cfg := config.NewConfig()
+			cfg.Set(config.KafkaSASLMechanism, mechanism)
+
+			got, err := newKafkaSASLMechanism(cfg)
+			if wantErr {
+				require.ErrorContains(t, err, "unknown kafka.sasl.mechanism")
+				return
+			}
+			require.NoError(t, err)
+			if mechanism == "" {
+				require.Nil(t, got)
+				return
+			}
+			require.Equal(t, mechanism, got.Name())
+		})
+	}
+}
+
+func TestNewKafkaTLSConfig_Fail(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "not-pem.crt")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+
+	for name, c := range map[string]struct {
+		caFile   string
+		certFile string
+		keyFile  string
+		wantErr  string
+	}{
+		"missing ca file":  {caFile: filepath.Join(dir, "missing.crt"), wantErr: "read kafka tls ca file"},
+		"non PEM ca file":  {caFile: notPEM, wantErr: "has no PEM certificate"},
+		"cert without key": {certFile: notPEM, wantErr: "load kafka tls client certificate"},
+		"key without cert": {keyFile: notPEM, wantErr: "load kafka tls client certificate"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.NewConfig()
+			cfg.Set(config.KafkaTLSEnabled, true)
+			cfg.Set(config.KafkaTLSCAFile, c.caFile)
+			cfg.Set(config.KafkaTLSCertFile, c.certFile)
+			cfg.Set(config.KafkaTLSKeyFile, c.keyFile)
+
+			_, err := newKafkaTLSConfig(cfg)
+			require.ErrorContains(t, err, c.wantErr)
+		})
+	}
+}
+
+func TestNewKafkaTLSConfig_Disabled(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.Set(config.KafkaTLSCAFile, "missing.crt")
+
+	got, err := newKafkaTLSConfig(cfg)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,33 @@
+package dlqusecase
+
+import (
+	"context"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/messaging"
+)
+
+//go:generate moq -out=../../mock/MockUsecaseDLQ.go -pkg=mock . DLQUsecase
+
+type DLQUsecase interface {
+	List(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error)
+	Replay(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error)
+}
+
+var _ DLQUsecase = &DLQUsecaseImpl{}
+
+type DLQUsecaseImpl struct {
+	Config    *config.Config
+	DLQClient messaging.DLQClient
+}
+
+func NewDLQUsecase(
+	cfg *config.Config,
+	dlqClient messaging.DLQClient,
+) *DLQUsecaseImpl {
+	return &DLQUsecaseImpl{
+		Config:    cfg,
+		DLQClient: dlqClient,
+	}
+}
@@ -0,0 +1,54 @@
+package dlqusecase
+
+import (
+	"context"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/sirupsen/logrus"
+)
+
+var _ DLQUsecase = &DLQUsecaseMwLogger{}
+
+type DLQUsecaseMwLogger struct {
+	Next DLQUsecase
+}
+
+func NewDLQUsecaseMwLogger(next DLQUsecase) *DLQUsecaseMwLogger {
+	return &DLQUsecaseMwLogger{
+		Next: next,
+	}
+}
+
+func (u *DLQUsecaseMwLogger) List(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	res, err := u.Next.List(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req":   req,
+		"count": len(res),
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return res, err
+}
+
+func (u *DLQUsecaseMwLogger) Replay(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	res, err := u.Next.Replay(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+		"res": res,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return res, err
+}
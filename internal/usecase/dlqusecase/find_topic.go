@@ -0,0 +1,19 @@
+package dlqusecase
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+)
+
+func findTopic(primary string) (topic.Topic, error) {
+	_topic, ok := topic.Find(primary)
+	if !ok {
+		err := fmt.Errorf("unknown topic %q", primary)
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return topic.Topic{}, errkit.AddFuncName(err, "dlqusecase.findTopic")
+	}
+	return _topic, nil
+}
@@ -0,0 +1,31 @@
+package dlqusecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func (u *DLQUsecaseImpl) List(ctx context.Context, req dto.ListDLQRequest) (dto.DLQRecordList, error) {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return nil, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).List")
+	}
+
+	_topic, err := findTopic(req.Topic)
+	if err != nil {
+		return nil, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).List")
+	}
+
+	records := dto.DLQRecordList{}
+	err = u.DLQClient.Read(ctx, _topic, req.Since, req.Until, req.Limit, &records)
+	if err != nil {
+		return nil, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).List")
+	}
+
+	return records, nil
+}
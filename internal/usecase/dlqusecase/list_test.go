@@ -0,0 +1,40 @@
+package dlqusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/dlqusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDLQUsecaseImpl_List_Success(t *testing.T) {
+	DLQClient := newDLQClientMock(dto.DLQRecordList{{Offset: 1}, {Offset: 2}})
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	res, err := u.List(context.Background(), dto.ListDLQRequest{
+		Topic: topic.ImageLiked.Primary,
+		Limit: 2,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, dto.DLQRecordList{{Offset: 1}, {Offset: 2}}, res)
+	require.Len(t, DLQClient.ReadCalls(), 1)
+	require.Equal(t, topic.ImageLiked, DLQClient.ReadCalls()[0].TopicName)
+	require.Equal(t, 2, DLQClient.ReadCalls()[0].Limit)
+}
+
+func TestDLQUsecaseImpl_List_Fail_UnknownTopic(t *testing.T) {
+	DLQClient := newDLQClientMock(nil)
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	_, err := u.List(context.Background(), dto.ListDLQRequest{Topic: "unknown"})
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, DLQClient.ReadCalls())
+}
@@ -0,0 +1,68 @@
+package dlqusecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func (u *DLQUsecaseImpl) Replay(ctx context.Context, req dto.ReplayDLQRequest) (dto.ReplayDLQResponse, error) {
+	res := dto.ReplayDLQResponse{}
+
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return res, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).Replay")
+	}
+
+	// never replay a whole DLQ by accident
+	if len(req.Offsets) == 0 && req.Since.IsZero() && req.Until.IsZero() {
+		err = fmt.Errorf("select records to replay by offsets or by a time range")
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return res, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).Replay")
+	}
+
+	_topic, err := findTopic(req.Topic)
+	if err != nil {
+		return res, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).Replay")
+	}
+
+	const limit = 0 // every record of the range
+	records := dto.DLQRecordList{}
+	err = u.DLQClient.Read(ctx, _topic, req.Since, req.Until, limit, &records)
+	if err != nil {
+		return res, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).Replay")
+	}
+
+	if len(req.Offsets) > 0 {
+		selected := make(map[dto.DLQOffset]bool, len(req.Offsets))
+		for _, o := range req.Offsets {
+			selected[o] = true
+		}
+
+		filtered := dto.DLQRecordList{}
+		for _, record := range records {
+			if selected[dto.DLQOffset{Partition: record.Partition, Offset: record.Offset}] {
+				filtered = append(filtered, record)
+			}
+		}
+		records = filtered
+	}
+
+	if len(records) == 0 {
+		return res, nil
+	}
+
+	err = u.DLQClient.Replay(ctx, _topic, records)
+	if err != nil {
+		return res, errkit.AddFuncName(err, "dlqusecase.(*DLQUsecaseImpl).Replay")
+	}
+
+	res.Replayed = len(records)
+
+	return res, nil
+}
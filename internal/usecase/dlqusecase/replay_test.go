@@ -0,0 +1,103 @@
+package dlqusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/dlqusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDLQClientMock(records dto.DLQRecordList) *mock.DLQClientMock {
+	return &mock.DLQClientMock{
+		ReadFunc: func(ctx context.Context, topicName topic.Topic, since time.Time, until time.Time, limit int, recordsMoqParam *dto.DLQRecordList) error {
+			*recordsMoqParam = records
+			return nil
+		},
+		ReplayFunc: func(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+			return nil
+		},
+	}
+}
+
+func TestDLQUsecaseImpl_Replay_Success_Offsets(t *testing.T) {
+	DLQClient := newDLQClientMock(dto.DLQRecordList{
+		{Partition: 0, Offset: 10},
+		{Partition: 0, Offset: 11},
+		{Partition: 1, Offset: 10},
+	})
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	res, err := u.Replay(context.Background(), dto.ReplayDLQRequest{
+		Topic:   topic.ImageLiked.Primary,
+		Offsets: dto.DLQOffsetList{{Partition: 0, Offset: 11}, {Partition: 1, Offset: 10}},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, dto.ReplayDLQResponse{Replayed: 2}, res)
+	require.Len(t, DLQClient.ReplayCalls(), 1)
+	require.Equal(t, topic.ImageLiked, DLQClient.ReplayCalls()[0].TopicName)
+	require.Equal(t, dto.DLQRecordList{{Partition: 0, Offset: 11}, {Partition: 1, Offset: 10}}, DLQClient.ReplayCalls()[0].Records)
+}
+
+func TestDLQUsecaseImpl_Replay_Success_TimeRange(t *testing.T) {
+	DLQClient := newDLQClientMock(dto.DLQRecordList{{Offset: 1}, {Offset: 2}})
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	res, err := u.Replay(context.Background(), dto.ReplayDLQRequest{
+		Topic: topic.ImageLiked.Primary,
+		Since: since,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 2, res.Replayed)
+	require.Equal(t, since, DLQClient.ReadCalls()[0].Since)
+}
+
+func TestDLQUsecaseImpl_Replay_Fail_NoSelection(t *testing.T) {
+	DLQClient := newDLQClientMock(nil)
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	_, err := u.Replay(context.Background(), dto.ReplayDLQRequest{Topic: topic.ImageLiked.Primary})
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, DLQClient.ReadCalls())
+}
+
+func TestDLQUsecaseImpl_Replay_Fail_UnknownTopic(t *testing.T) {
+	DLQClient := newDLQClientMock(nil)
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	_, err := u.Replay(context.Background(), dto.ReplayDLQRequest{
+		Topic:   "unknown",
+		Offsets: dto.DLQOffsetList{{Partition: 0, Offset: 1}},
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, errkit.GetHTTPError(err).HTTPCode)
+}
+
+func TestDLQUsecaseImpl_Replay_Fail_Replay(t *testing.T) {
+	DLQClient := newDLQClientMock(dto.DLQRecordList{{Offset: 1}})
+	DLQClient.ReplayFunc = func(ctx context.Context, topicName topic.Topic, records dto.DLQRecordList) error {
+		return assert.AnError
+	}
+	u := &dlqusecase.DLQUsecaseImpl{DLQClient: DLQClient}
+
+	res, err := u.Replay(context.Background(), dto.ReplayDLQRequest{
+		Topic:   topic.ImageLiked.Primary,
+		Offsets: dto.DLQOffsetList{{Partition: 0, Offset: 1}},
+	})
+
+	require.ErrorIs(t, err, assert.AnError)
+	require.Equal(t, 0, res.Replayed)
+}
@@ -0,0 +1,15 @@
+package idempotencyusecase
+
+import (
+	"context"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+)
+
+func (u *IdempotencyUsecaseImpl) Delete(ctx context.Context, consumerGroup, key string) error {
+	err := u.IdempotencyRepository.Delete(ctx, u.DB, consumerGroup, key)
+	if err != nil {
+		return errkit.AddFuncName(err, "idempotencyusecase.(*IdempotencyUsecaseImpl).Delete")
+	}
+	return nil
+}
@@ -12,8 +12,9 @@ import (
 //go:generate moq -out=../../mock/MockUsecaseIdempotency.go -pkg=mock . IdempotencyUsecase
 
 type IdempotencyUsecase interface {
-	InsertIfNotExists(ctx context.Context, key, topic string, partition int32, offset int64) (bool, error)
+	InsertIfNotExists(ctx context.Context, consumerGroup, key, topic string, partition int32, offset int64) (bool, error)
 	DeleteOlderThan(ctx context.Context, age time.Duration) (int64, error)
+	Delete(ctx context.Context, consumerGroup, key string) error
 }
 
 var _ IdempotencyUsecase = &IdempotencyUsecaseImpl{}
@@ -21,14 +21,15 @@ func NewIdempotencyUsecaseMwLogger(next IdempotencyUsecase) *IdempotencyUsecaseM
 	}
 }
 
-func (u *IdempotencyUsecaseMwLogger) InsertIfNotExists(ctx context.Context, key, topic string, partition int32, offset int64) (bool, error) {
+func (u *IdempotencyUsecaseMwLogger) InsertIfNotExists(ctx context.Context, consumerGroup, key, topic string, partition int32, offset int64) (bool, error) {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
 
-	isNew, err := u.Next.InsertIfNotExists(ctx, key, topic, partition, offset)
+	isNew, err := u.Next.InsertIfNotExists(ctx, consumerGroup, key, topic, partition, offset)
 	telemetry.RecordError(span, err)
 
 	fields := logrus.Fields{
+		"consumer_group":  consumerGroup,
 		"idempotency_key": key,
 		"topic":           topic,
 		"partition":       partition,
@@ -55,3 +56,19 @@ func (u *IdempotencyUsecaseMwLogger) DeleteOlderThan(ctx context.Context, age ti
 
 	return deleted, err
 }
+
+func (u *IdempotencyUsecaseMwLogger) Delete(ctx context.Context, consumerGroup, key string) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.Delete(ctx, consumerGroup, key)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"consumer_group":  consumerGroup,
+		"idempotency_key": key,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
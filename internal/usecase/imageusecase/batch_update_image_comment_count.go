@@ -6,8 +6,8 @@ import (
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
 )
 
 func (u *ImageUsecaseImpl) BatchUpdateImageCommentCount(ctx context.Context, req dto.BatchUpdateImageCommentCountRequest) error {
@@ -17,11 +17,17 @@ func (u *ImageUsecaseImpl) BatchUpdateImageCommentCount(ctx context.Context, req
 		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).BatchUpdateImageCommentCount")
 	}
 
-	for _, v := range req.ImageIncreaseCommentCountList {
-		err = u.ImageRepository.IncrementCommentCountByID(ctx, u.DB, v.ImageID, v.Count)
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).WithField("v", v).Warn()
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range req.ImageIncreaseCommentCountList {
+			err := u.ImageRepository.IncrementCommentCountByID(ctx, tx, v.ImageID, v.Count)
+			if err != nil {
+				return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).BatchUpdateImageCommentCount")
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return nil
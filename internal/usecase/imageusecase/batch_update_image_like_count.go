@@ -6,8 +6,8 @@ import (
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
-	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
 )
 
 func (u *ImageUsecaseImpl) BatchUpdateImageLikeCount(ctx context.Context, req dto.BatchUpdateImageLikeCountRequest) error {
@@ -17,11 +17,17 @@ func (u *ImageUsecaseImpl) BatchUpdateImageLikeCount(ctx context.Context, req dt
 		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).BatchUpdateImageLikeCount")
 	}
 
-	for _, v := range req.ImageIncreaseLikeCountList {
-		err = u.ImageRepository.IncrementLikeCountByID(ctx, u.DB, v.ImageID, v.Count)
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).WithField("v", v).Warn()
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range req.ImageIncreaseLikeCountList {
+			err := u.ImageRepository.IncrementLikeCountByID(ctx, tx, v.ImageID, v.Count)
+			if err != nil {
+				return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).BatchUpdateImageLikeCount")
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,68 @@
+package imageusecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+func (u *ImageUsecaseImpl) DeleteComment(ctx context.Context, req dto.DeleteCommentRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		comment := entity.Comment{}
+		err := u.findCommentOfImage(ctx, tx, &comment, req.ImageID, req.CommentID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+		}
+
+		// the commenter can delete their comment, the image owner any comment on the image
+		if comment.UserID != userAuth.ID {
+			image := entity.Image{}
+			err = u.ImageRepository.FindByID(ctx, tx, &image, req.ImageID)
+			if err != nil {
+				return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+			}
+
+			if image.UserID != userAuth.ID {
+				err = fmt.Errorf("only the commenter or the image owner can delete the comment")
+				err = errkit.SetCode(err, http.StatusForbidden)
+				return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+			}
+		}
+
+		err = u.CommentRepository.Delete(ctx, tx, &comment)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+		}
+
+		event := dto.ImageCommentDeletedEvent{}
+		converter.EntityCommentToDtoImageCommentDeletedEvent(comment, &event)
+
+		err = u.ImageProducer.SendImageCommentDeleted(ctx, tx, &event)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteComment")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
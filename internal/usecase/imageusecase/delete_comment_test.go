@@ -0,0 +1,203 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_DeleteComment_Success_Commenter(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+		ImageRepository:   ImageRepository,
+		ImageProducer:     ImageProducer,
+	}
+
+	req := &dto.DeleteCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 1
+		comment.ImageID = 100
+		comment.Comment = "nice pic"
+		return nil
+	}
+
+	CommentRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+		return nil
+	}
+
+	var sent dto.ImageCommentDeletedEvent
+	ImageProducer.SendImageCommentDeletedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+		sent = *event
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.DeleteComment(ctx, *req)
+
+	require.Nil(t, err)
+	require.Empty(t, ImageRepository.FindByIDCalls())
+	require.Equal(t, int64(4), CommentRepository.DeleteCalls()[0].Comment.ID)
+	require.Equal(t, dto.ImageCommentDeletedEvent{ID: 4, UserID: 1, ImageID: 100, Comment: "nice pic"}, sent)
+}
+
+func TestImageUsecaseImpl_DeleteComment_Success_ImageOwner(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+		ImageRepository:   ImageRepository,
+		ImageProducer:     ImageProducer,
+	}
+
+	req := &dto.DeleteCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 2
+		comment.ImageID = 100
+		return nil
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 1
+		return nil
+	}
+
+	CommentRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+		return nil
+	}
+
+	ImageProducer.SendImageCommentDeletedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.DeleteComment(ctx, *req)
+
+	require.Nil(t, err)
+	require.Len(t, CommentRepository.DeleteCalls(), 1)
+	require.Len(t, ImageProducer.SendImageCommentDeletedCalls(), 1)
+}
+
+func TestImageUsecaseImpl_DeleteComment_Fail_NotCommenterNorImageOwner(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageRepository := &mock.ImageRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+		ImageRepository:   ImageRepository,
+	}
+
+	req := &dto.DeleteCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 2
+		comment.ImageID = 100
+		return nil
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 3
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.DeleteComment(ctx, *req)
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, CommentRepository.DeleteCalls())
+}
+
+func TestImageUsecaseImpl_DeleteComment_Fail_Send(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+		ImageProducer:     ImageProducer,
+	}
+
+	req := &dto.DeleteCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.UserID = 1
+		comment.ImageID = 100
+		return nil
+	}
+
+	CommentRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+		return nil
+	}
+
+	ImageProducer.SendImageCommentDeletedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageCommentDeletedEvent) error {
+		return assert.AnError
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.DeleteComment(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, assert.AnError)
+}
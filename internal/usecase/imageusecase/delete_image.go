@@ -0,0 +1,71 @@
+package imageusecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+// DeleteImage soft deletes the image with its likes and comments. The object in storage
+// and the search document are deleted by the consumers of the image.deleted event.
+func (u *ImageUsecaseImpl) DeleteImage(ctx context.Context, req dto.DeleteImageRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		image := entity.Image{}
+		err := u.ImageRepository.FindByID(ctx, tx, &image, req.ID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		if image.UserID != userAuth.ID {
+			err = fmt.Errorf("only the image owner can delete the image")
+			err = errkit.SetCode(err, http.StatusForbidden)
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		err = u.ImageRepository.Delete(ctx, tx, &image)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		err = u.LikeRepository.DeleteByImageID(ctx, tx, image.ID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		err = u.CommentRepository.DeleteByImageID(ctx, tx, image.ID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		event := dto.ImageDeletedEvent{}
+		converter.EntityImageToDtoImageDeletedEvent(image, &event)
+
+		err = u.ImageProducer.SendImageDeleted(ctx, tx, &event)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImage")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
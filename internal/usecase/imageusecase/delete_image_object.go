@@ -0,0 +1,25 @@
+package imageusecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func (u *ImageUsecaseImpl) DeleteImageObject(ctx context.Context, req dto.DeleteImageObjectRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImageObject")
+	}
+
+	err = u.S3Client.DeleteImage(ctx, dto.S3DeleteImageRequest{URL: req.URL})
+	if err != nil {
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).DeleteImageObject")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package imageusecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageUsecaseImpl_DeleteImageObject_Success(t *testing.T) {
+	S3Client := &mock.S3ClientMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		S3Client: S3Client,
+	}
+
+	S3Client.DeleteImageFunc = func(ctx context.Context, req dto.S3DeleteImageRequest) error {
+		return nil
+	}
+
+	err := u.DeleteImageObject(context.Background(), dto.DeleteImageObjectRequest{URL: "http://localhost:9000/image/1.jpg"})
+
+	require.Nil(t, err)
+	require.Len(t, S3Client.DeleteImageCalls(), 1)
+	require.Equal(t, "http://localhost:9000/image/1.jpg", S3Client.DeleteImageCalls()[0].Req.URL)
+}
+
+func TestImageUsecaseImpl_DeleteImageObject_Fail_ValidateStruct(t *testing.T) {
+	S3Client := &mock.S3ClientMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		S3Client: S3Client,
+	}
+
+	err := u.DeleteImageObject(context.Background(), dto.DeleteImageObjectRequest{}) // invalid
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	require.Empty(t, S3Client.DeleteImageCalls())
+}
+
+func TestImageUsecaseImpl_DeleteImageObject_Fail_DeleteImage(t *testing.T) {
+	S3Client := &mock.S3ClientMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		S3Client: S3Client,
+	}
+
+	S3Client.DeleteImageFunc = func(ctx context.Context, req dto.S3DeleteImageRequest) error {
+		return errkit.WrapNonRetryable(assert.AnError)
+	}
+
+	err := u.DeleteImageObject(context.Background(), dto.DeleteImageObjectRequest{URL: "http://localhost:9000/avatar/1.jpg"})
+
+	require.ErrorIs(t, err, assert.AnError)
+	require.True(t, errkit.IsNonRetryable(err))
+}
@@ -0,0 +1,177 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_DeleteImage_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	LikeRepository := &mock.LikeRepositoryMock{}
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		ImageRepository:   ImageRepository,
+		LikeRepository:    LikeRepository,
+		CommentRepository: CommentRepository,
+		ImageProducer:     ImageProducer,
+	}
+
+	req := &dto.DeleteImageRequest{
+		ID: 100,
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 1
+		image.URL = "http://localhost:9000/image/user/1_a.png"
+		return nil
+	}
+
+	ImageRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+		return nil
+	}
+
+	LikeRepository.DeleteByImageIDFunc = func(ctx context.Context, db *gorm.DB, imageID int64) error {
+		return nil
+	}
+
+	CommentRepository.DeleteByImageIDFunc = func(ctx context.Context, db *gorm.DB, imageID int64) error {
+		return nil
+	}
+
+	var sent dto.ImageDeletedEvent
+	ImageProducer.SendImageDeletedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+		sent = *event
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.DeleteImage(ctx, *req)
+
+	require.Nil(t, err)
+	require.Equal(t, int64(100), ImageRepository.DeleteCalls()[0].Image.ID)
+	require.Equal(t, int64(100), LikeRepository.DeleteByImageIDCalls()[0].ImageID)
+	require.Equal(t, int64(100), CommentRepository.DeleteByImageIDCalls()[0].ImageID)
+	require.Equal(t, dto.ImageDeletedEvent{ID: 100, UserID: 1, URL: "http://localhost:9000/image/user/1_a.png"}, sent)
+}
+
+func TestImageUsecaseImpl_DeleteImage_Fail_ValidateStruct(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	u := &imageusecase.ImageUsecaseImpl{
+		DB: gormDB,
+	}
+
+	req := &dto.DeleteImageRequest{}
+
+	err := u.DeleteImage(context.Background(), *req)
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+}
+
+func TestImageUsecaseImpl_DeleteImage_Fail_NotOwner(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+	}
+
+	req := &dto.DeleteImageRequest{
+		ID: 100,
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 2
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.DeleteImage(ctx, *req)
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, ImageRepository.DeleteCalls())
+}
+
+func TestImageUsecaseImpl_DeleteImage_Fail_Send(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	LikeRepository := &mock.LikeRepositoryMock{}
+	CommentRepository := &mock.CommentRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		ImageRepository:   ImageRepository,
+		LikeRepository:    LikeRepository,
+		CommentRepository: CommentRepository,
+		ImageProducer:     ImageProducer,
+	}
+
+	req := &dto.DeleteImageRequest{
+		ID: 100,
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 1
+		return nil
+	}
+
+	ImageRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+		return nil
+	}
+
+	LikeRepository.DeleteByImageIDFunc = func(ctx context.Context, db *gorm.DB, imageID int64) error {
+		return nil
+	}
+
+	CommentRepository.DeleteByImageIDFunc = func(ctx context.Context, db *gorm.DB, imageID int64) error {
+		return nil
+	}
+
+	ImageProducer.SendImageDeletedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageDeletedEvent) error {
+		return assert.AnError
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.DeleteImage(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -49,6 +49,34 @@ func TestImageUsecaseImpl_GetImage_Success(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestImageUsecaseImpl_GetImage_Success_NegativeCountsShownAsZero(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+	}
+
+	req := &dto.GetImageRequest{
+		ID: 100,
+	}
+
+	// an unlike counted before its like, a deleted comment before its comment
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, entityMoqParam *entity.Image, id int64) error {
+		entityMoqParam.ID = 100
+		entityMoqParam.LikeCount = -1
+		entityMoqParam.CommentCount = -1
+		return nil
+	}
+
+	res, err := u.GetImage(context.Background(), *req)
+
+	require.Nil(t, err)
+	require.Equal(t, 0, res.LikeCount)
+	require.Equal(t, 0, res.CommentCount)
+}
+
 func TestImageUsecaseImpl_GetImage_Fail_ValidateStruct(t *testing.T) {
 	gormDB, _ := newFakeDB(t)
 	u := &imageusecase.ImageUsecaseImpl{
@@ -16,13 +16,20 @@ import (
 
 type ImageUsecase interface {
 	Upload(ctx context.Context, req dto.UploadImageRequest) (dto.ImageResponse, error)
+	UpdateImage(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error)
+	DeleteImage(ctx context.Context, req dto.DeleteImageRequest) error
 	Like(ctx context.Context, req dto.LikeImageRequest) error
+	Unlike(ctx context.Context, req dto.UnlikeImageRequest) error
 	Comment(ctx context.Context, req dto.CommentImageRequest) error
+	UpdateComment(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error)
+	DeleteComment(ctx context.Context, req dto.DeleteCommentRequest) error
 	GetImage(ctx context.Context, req dto.GetImageRequest) (dto.ImageResponse, error)
 	GetLike(ctx context.Context, req dto.GetLikeRequest) (dto.LikeResponseList, error)
 	GetComment(ctx context.Context, req dto.GetCommentRequest) (dto.CommentResponseList, error)
 	NotifyFollowerOnUpload(ctx context.Context, req dto.NotifyFollowerOnUploadRequest) error
 	SyncImageToElasticsearch(ctx context.Context, req dto.SyncImageToElasticsearchRequest) error
+	DeleteImageObject(ctx context.Context, req dto.DeleteImageObjectRequest) error
+	RemoveImageFromElasticsearch(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error
 	NotifyUserImageCommented(ctx context.Context, req dto.NotifyUserImageCommentedRequest) error
 	BatchUpdateImageCommentCount(ctx context.Context, req dto.BatchUpdateImageCommentCountRequest) error
 	NotifyUserImageLiked(ctx context.Context, req dto.NotifyUserImageLikedRequest) error
@@ -36,6 +36,68 @@ func (u *ImageUsecaseMwLogger) Comment(ctx context.Context, req dto.CommentImage
 	return err
 }
 
+func (u *ImageUsecaseMwLogger) UpdateComment(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	res, err := u.Next.UpdateComment(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+		"res": res,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return res, err
+}
+
+func (u *ImageUsecaseMwLogger) DeleteComment(ctx context.Context, req dto.DeleteCommentRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.DeleteComment(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (u *ImageUsecaseMwLogger) UpdateImage(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	res, err := u.Next.UpdateImage(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+		"res": res,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return res, err
+}
+
+func (u *ImageUsecaseMwLogger) DeleteImage(ctx context.Context, req dto.DeleteImageRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.DeleteImage(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (u *ImageUsecaseMwLogger) Like(ctx context.Context, req dto.LikeImageRequest) error {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -51,6 +113,21 @@ func (u *ImageUsecaseMwLogger) Like(ctx context.Context, req dto.LikeImageReques
 	return err
 }
 
+func (u *ImageUsecaseMwLogger) Unlike(ctx context.Context, req dto.UnlikeImageRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.Unlike(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (u *ImageUsecaseMwLogger) Upload(ctx context.Context, req dto.UploadImageRequest) (dto.ImageResponse, error) {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -204,3 +281,33 @@ func (u *ImageUsecaseMwLogger) BatchUpdateImageLikeCount(ctx context.Context, re
 
 	return err
 }
+
+func (u *ImageUsecaseMwLogger) DeleteImageObject(ctx context.Context, req dto.DeleteImageObjectRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.DeleteImageObject(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
+func (u *ImageUsecaseMwLogger) RemoveImageFromElasticsearch(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.RemoveImageFromElasticsearch(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
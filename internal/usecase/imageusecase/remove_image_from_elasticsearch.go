@@ -0,0 +1,25 @@
+package imageusecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+)
+
+func (u *ImageUsecaseImpl) RemoveImageFromElasticsearch(ctx context.Context, req dto.RemoveImageFromElasticsearchRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).RemoveImageFromElasticsearch")
+	}
+
+	err = u.ImageSearch.DeleteImage(ctx, req.ID, req.DeletedAt)
+	if err != nil {
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).RemoveImageFromElasticsearch")
+	}
+
+	return nil
+}
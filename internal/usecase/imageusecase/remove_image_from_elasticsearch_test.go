@@ -0,0 +1,65 @@
+package imageusecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageUsecaseImpl_RemoveImageFromElasticsearch_Success(t *testing.T) {
+	ImageSearch := &mock.ImageSearchMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		ImageSearch: ImageSearch,
+	}
+
+	ImageSearch.DeleteImageFunc = func(ctx context.Context, id int64, deletedAt time.Time) error {
+		return nil
+	}
+
+	deletedAt := time.UnixMicro(1767225600000000)
+	err := u.RemoveImageFromElasticsearch(context.Background(), dto.RemoveImageFromElasticsearchRequest{ID: 100, DeletedAt: deletedAt})
+
+	require.Nil(t, err)
+	require.Len(t, ImageSearch.DeleteImageCalls(), 1)
+	require.Equal(t, int64(100), ImageSearch.DeleteImageCalls()[0].ID)
+	require.Equal(t, deletedAt, ImageSearch.DeleteImageCalls()[0].DeletedAt)
+}
+
+func TestImageUsecaseImpl_RemoveImageFromElasticsearch_Fail_ValidateStruct(t *testing.T) {
+	ImageSearch := &mock.ImageSearchMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		ImageSearch: ImageSearch,
+	}
+
+	err := u.RemoveImageFromElasticsearch(context.Background(), dto.RemoveImageFromElasticsearchRequest{}) // invalid
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	require.Empty(t, ImageSearch.DeleteImageCalls())
+}
+
+func TestImageUsecaseImpl_RemoveImageFromElasticsearch_Fail_DeleteImage(t *testing.T) {
+	ImageSearch := &mock.ImageSearchMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		ImageSearch: ImageSearch,
+	}
+
+	ImageSearch.DeleteImageFunc = func(ctx context.Context, id int64, deletedAt time.Time) error {
+		return assert.AnError
+	}
+
+	err := u.RemoveImageFromElasticsearch(context.Background(), dto.RemoveImageFromElasticsearchRequest{ID: 100, DeletedAt: time.UnixMicro(1767225600000000)})
+
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -2,12 +2,16 @@ package imageusecase
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
 	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
 )
 
 func (u *ImageUsecaseImpl) SyncImageToElasticsearch(ctx context.Context, req dto.SyncImageToElasticsearchRequest) error {
@@ -17,6 +21,18 @@ func (u *ImageUsecaseImpl) SyncImageToElasticsearch(ctx context.Context, req dto
 		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).SyncImageToElasticsearch")
 	}
 
+	// image.uploaded or image.updated consumed after image.deleted must not index the
+	// deleted image again, so index only images that still exist
+	image := entity.Image{}
+	err = u.ImageRepository.FindByID(ctx, u.DB, &image, req.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logkit.Logger.WithContext(ctx).WithField("image_id", req.ID).Info("image is deleted, skip indexing")
+		return nil
+	}
+	if err != nil {
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).SyncImageToElasticsearch")
+	}
+
 	imageDocument := dto.ImageDocument{}
 	converter.DtoSyncImageToElasticsearchRequestToDtoImageDocument(req, &imageDocument)
 
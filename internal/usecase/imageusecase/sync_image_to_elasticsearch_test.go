@@ -0,0 +1,74 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_SyncImageToElasticsearch_Success(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageSearch := &mock.ImageSearchMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+		ImageSearch:     ImageSearch,
+	}
+
+	req := &dto.SyncImageToElasticsearchRequest{
+		ID:      100,
+		UserID:  1,
+		Caption: "sunrise",
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		return nil
+	}
+
+	ImageSearch.IndexImageFunc = func(ctx context.Context, document *dto.ImageDocument) error {
+		return nil
+	}
+
+	err := u.SyncImageToElasticsearch(context.Background(), *req)
+
+	require.Nil(t, err)
+	require.Len(t, ImageSearch.IndexImageCalls(), 1)
+	require.Equal(t, int64(100), ImageSearch.IndexImageCalls()[0].Document.ID)
+	require.Equal(t, "sunrise", ImageSearch.IndexImageCalls()[0].Document.Caption)
+}
+
+func TestImageUsecaseImpl_SyncImageToElasticsearch_SkipDeletedImage(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageSearch := &mock.ImageSearchMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+		ImageSearch:     ImageSearch,
+	}
+
+	req := &dto.SyncImageToElasticsearchRequest{
+		ID: 100,
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		return errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+
+	err := u.SyncImageToElasticsearch(context.Background(), *req)
+
+	require.Nil(t, err)
+	require.Empty(t, ImageSearch.IndexImageCalls())
+}
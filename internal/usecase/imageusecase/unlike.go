@@ -0,0 +1,52 @@
+package imageusecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+func (u *ImageUsecaseImpl) Unlike(ctx context.Context, req dto.UnlikeImageRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).Unlike")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		like := entity.Like{}
+		err := u.LikeRepository.FindByUserIDAndImageID(ctx, tx, &like, userAuth.ID, req.ImageID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).Unlike")
+		}
+
+		err = u.LikeRepository.Delete(ctx, tx, &like)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).Unlike")
+		}
+
+		event := dto.ImageUnlikedEvent{}
+		converter.EntityLikeToDtoImageUnlikedEvent(like, &event)
+
+		err = u.ImageProducer.SendImageUnliked(ctx, tx, &event)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).Unlike")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
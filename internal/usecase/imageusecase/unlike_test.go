@@ -0,0 +1,148 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_Unlike_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	LikeRepository := &mock.LikeRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:             gormDB,
+		LikeRepository: LikeRepository,
+		ImageProducer:  ImageProducer,
+	}
+
+	req := &dto.UnlikeImageRequest{
+		ImageID: 100,
+	}
+
+	LikeRepository.FindByUserIDAndImageIDFunc = func(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+		like.ID = 7
+		like.UserID = userID
+		like.ImageID = imageID
+		return nil
+	}
+
+	LikeRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+		return nil
+	}
+
+	var sent dto.ImageUnlikedEvent
+	ImageProducer.SendImageUnlikedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+		sent = *event
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.Unlike(ctx, *req)
+
+	require.Nil(t, err)
+	require.Equal(t, int64(1), LikeRepository.FindByUserIDAndImageIDCalls()[0].UserID)
+	require.Equal(t, int64(7), LikeRepository.DeleteCalls()[0].Like.ID)
+	require.Equal(t, dto.ImageUnlikedEvent{ID: 7, UserID: 1, ImageID: 100}, sent)
+}
+
+func TestImageUsecaseImpl_Unlike_Fail_ValidateStruct(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	u := &imageusecase.ImageUsecaseImpl{
+		DB: gormDB,
+	}
+
+	req := &dto.UnlikeImageRequest{}
+
+	err := u.Unlike(context.Background(), *req)
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+}
+
+func TestImageUsecaseImpl_Unlike_Fail_NotLiked(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	LikeRepository := &mock.LikeRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:             gormDB,
+		LikeRepository: LikeRepository,
+	}
+
+	req := &dto.UnlikeImageRequest{
+		ImageID: 100,
+	}
+
+	LikeRepository.FindByUserIDAndImageIDFunc = func(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+		return errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.Unlike(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.Empty(t, LikeRepository.DeleteCalls())
+}
+
+func TestImageUsecaseImpl_Unlike_Fail_Send(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	LikeRepository := &mock.LikeRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:             gormDB,
+		LikeRepository: LikeRepository,
+		ImageProducer:  ImageProducer,
+	}
+
+	req := &dto.UnlikeImageRequest{
+		ImageID: 100,
+	}
+
+	LikeRepository.FindByUserIDAndImageIDFunc = func(ctx context.Context, db *gorm.DB, like *entity.Like, userID int64, imageID int64) error {
+		return nil
+	}
+
+	LikeRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, like *entity.Like) error {
+		return nil
+	}
+
+	ImageProducer.SendImageUnlikedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageUnlikedEvent) error {
+		return assert.AnError
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.Unlike(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, assert.AnError)
+}
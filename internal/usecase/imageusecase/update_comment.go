@@ -0,0 +1,65 @@
+package imageusecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+func (u *ImageUsecaseImpl) UpdateComment(ctx context.Context, req dto.UpdateCommentRequest) (dto.CommentResponse, error) {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return dto.CommentResponse{}, errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateComment")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	comment := entity.Comment{}
+	err = u.findCommentOfImage(ctx, u.DB, &comment, req.ImageID, req.CommentID)
+	if err != nil {
+		return dto.CommentResponse{}, errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateComment")
+	}
+
+	if comment.UserID != userAuth.ID {
+		err = fmt.Errorf("only the commenter can edit the comment")
+		err = errkit.SetCode(err, http.StatusForbidden)
+		return dto.CommentResponse{}, errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateComment")
+	}
+
+	comment.Comment = req.Comment
+
+	err = u.CommentRepository.Update(ctx, u.DB, &comment)
+	if err != nil {
+		return dto.CommentResponse{}, errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateComment")
+	}
+
+	res := dto.CommentResponse{}
+	converter.EntityCommentToDtoCommentResponse(comment, &res)
+
+	return res, nil
+}
+
+// findCommentOfImage finds the comment, a comment of another image is not found either, so
+// the image id in the path can not be used to reach comments of other images.
+func (u *ImageUsecaseImpl) findCommentOfImage(ctx context.Context, db *gorm.DB, comment *entity.Comment, imageID int64, commentID int64) error {
+	err := u.CommentRepository.FindByID(ctx, db, comment, commentID)
+	if err != nil {
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).findCommentOfImage")
+	}
+
+	if comment.ImageID != imageID {
+		err = errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+		return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).findCommentOfImage")
+	}
+
+	return nil
+}
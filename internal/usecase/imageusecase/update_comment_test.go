@@ -0,0 +1,133 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_UpdateComment_Success(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+	}
+
+	req := &dto.UpdateCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+		Comment:   "even nicer pic",
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 1
+		comment.ImageID = 100
+		comment.Comment = "nice pic"
+		return nil
+	}
+
+	CommentRepository.UpdateFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment) error {
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	res, err := u.UpdateComment(ctx, *req)
+
+	require.Nil(t, err)
+	require.Equal(t, "even nicer pic", CommentRepository.UpdateCalls()[0].Comment.Comment)
+	require.Equal(t, dto.CommentResponse{ID: 4, UserID: 1, ImageID: 100, Comment: "even nicer pic"}, res)
+}
+
+func TestImageUsecaseImpl_UpdateComment_Fail_ValidateStruct(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	u := &imageusecase.ImageUsecaseImpl{
+		DB: gormDB,
+	}
+
+	req := &dto.UpdateCommentRequest{ImageID: 100, CommentID: 4}
+
+	_, err := u.UpdateComment(context.Background(), *req)
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+}
+
+func TestImageUsecaseImpl_UpdateComment_Fail_NotCommenter(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+	}
+
+	req := &dto.UpdateCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+		Comment:   "even nicer pic",
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 2
+		comment.ImageID = 100
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	_, err := u.UpdateComment(ctx, *req)
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, CommentRepository.UpdateCalls())
+}
+
+func TestImageUsecaseImpl_UpdateComment_Fail_CommentOfOtherImage(t *testing.T) {
+	gormDB, _ := newFakeDB(t)
+	CommentRepository := &mock.CommentRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:                gormDB,
+		CommentRepository: CommentRepository,
+	}
+
+	req := &dto.UpdateCommentRequest{
+		ImageID:   100,
+		CommentID: 4,
+		Comment:   "even nicer pic",
+	}
+
+	CommentRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, comment *entity.Comment, id int64) error {
+		comment.ID = id
+		comment.UserID = 1
+		comment.ImageID = 200
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	_, err := u.UpdateComment(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.Equal(t, http.StatusNotFound, errkit.GetHTTPError(err).HTTPCode)
+}
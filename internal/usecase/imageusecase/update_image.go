@@ -0,0 +1,65 @@
+package imageusecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+func (u *ImageUsecaseImpl) UpdateImage(ctx context.Context, req dto.UpdateImageRequest) (dto.ImageResponse, error) {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return dto.ImageResponse{}, errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateImage")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	image := entity.Image{}
+
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		err := u.ImageRepository.FindByID(ctx, tx, &image, req.ID)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateImage")
+		}
+
+		if image.UserID != userAuth.ID {
+			err = fmt.Errorf("only the image owner can update the image")
+			err = errkit.SetCode(err, http.StatusForbidden)
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateImage")
+		}
+
+		image.Caption = req.Caption
+
+		err = u.ImageRepository.UpdateCaption(ctx, tx, &image)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateImage")
+		}
+
+		event := dto.ImageUpdatedEvent{}
+		converter.EntityImageToDtoImageUpdatedEvent(image, &event)
+
+		err = u.ImageProducer.SendImageUpdated(ctx, tx, &event)
+		if err != nil {
+			return errkit.AddFuncName(err, "imageusecase.(*ImageUsecaseImpl).UpdateImage")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.ImageResponse{}, err
+	}
+
+	res := dto.ImageResponse{}
+	converter.EntityImageToDtoImageResponse(image, &res)
+
+	return res, nil
+}
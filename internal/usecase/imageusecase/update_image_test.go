@@ -0,0 +1,140 @@
+package imageusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/imageusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestImageUsecaseImpl_UpdateImage_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+		ImageProducer:   ImageProducer,
+	}
+
+	req := &dto.UpdateImageRequest{
+		ID:      100,
+		Caption: "sunrise",
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 1
+		image.Caption = "sunset"
+		image.LikeCount = 3
+		return nil
+	}
+
+	ImageRepository.UpdateCaptionFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+		return nil
+	}
+
+	var sent dto.ImageUpdatedEvent
+	ImageProducer.SendImageUpdatedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+		sent = *event
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	res, err := u.UpdateImage(ctx, *req)
+
+	require.Nil(t, err)
+	require.Equal(t, "sunrise", ImageRepository.UpdateCaptionCalls()[0].Image.Caption)
+	require.Equal(t, dto.ImageUpdatedEvent{ID: 100, UserID: 1, Caption: "sunrise", LikeCount: 3}, sent)
+	require.Equal(t, "sunrise", res.Caption)
+}
+
+func TestImageUsecaseImpl_UpdateImage_Fail_NotOwner(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+	}
+
+	req := &dto.UpdateImageRequest{
+		ID:      100,
+		Caption: "sunrise",
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 2
+		return nil
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	_, err := u.UpdateImage(ctx, *req)
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, ImageRepository.UpdateCaptionCalls())
+}
+
+func TestImageUsecaseImpl_UpdateImage_Fail_Send(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	ImageRepository := &mock.ImageRepositoryMock{}
+	ImageProducer := &mock.ImageProducerMock{}
+
+	u := &imageusecase.ImageUsecaseImpl{
+		DB:              gormDB,
+		ImageRepository: ImageRepository,
+		ImageProducer:   ImageProducer,
+	}
+
+	req := &dto.UpdateImageRequest{
+		ID:      100,
+		Caption: "sunrise",
+	}
+
+	ImageRepository.FindByIDFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image, id int64) error {
+		image.ID = id
+		image.UserID = 1
+		return nil
+	}
+
+	ImageRepository.UpdateCaptionFunc = func(ctx context.Context, db *gorm.DB, image *entity.Image) error {
+		return nil
+	}
+
+	ImageProducer.SendImageUpdatedFunc = func(ctx context.Context, db *gorm.DB, event *dto.ImageUpdatedEvent) error {
+		return assert.AnError
+	}
+
+	ctx := context.Background()
+	ctx = ctxuserauth.Set(ctx, &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	_, err := u.UpdateImage(ctx, *req)
+
+	require.NotNil(t, err)
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,21 @@
+package outboxusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+)
+
+// CleanupFailed removes failed outbox rows, the ones given up on after the max attempts,
+// older than age, like CleanupProduced does. Give age the time needed to look into them,
+// a failed row is never produced again by the worker.
+func (u *OutboxUsecaseImpl) CleanupFailed(ctx context.Context, age time.Duration) (int64, error) {
+	total, err := u.cleanup(ctx, entity.OutboxStatusFailed, age)
+	if err != nil {
+		return total, errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).CleanupFailed")
+	}
+
+	return total, nil
+}
@@ -0,0 +1,80 @@
+package outboxusecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/outboxusecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestOutboxUsecaseImpl_CleanupFailed_Success_Archive(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	OutboxRepository := &mock.OutboxRepositoryMock{}
+
+	cfg := config.NewConfig()
+	cfg.Set(config.OutboxCleanupBatchSize, 10)
+	cfg.Set(config.OutboxCleanupArchive, true)
+
+	u := &outboxusecase.OutboxUsecaseImpl{
+		Config:           cfg,
+		DB:               gormDB,
+		OutboxRepository: OutboxRepository,
+	}
+
+	OutboxRepository.FindOlderThanFunc = func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+		*outboxes = entity.OutboxList{{ID: 1, Status: entity.OutboxStatusFailed, LastError: "boom"}}
+		return nil
+	}
+	OutboxRepository.InsertArchiveFunc = func(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+		return nil
+	}
+	OutboxRepository.DeleteByIDsFunc = func(ctx context.Context, db *gorm.DB, ids []int64) error {
+		return nil
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	removed, err := u.CleanupFailed(context.Background(), 30*24*time.Hour)
+
+	require.Nil(t, err)
+	require.Equal(t, int64(1), removed)
+	require.Len(t, OutboxRepository.FindOlderThanCalls(), 1)
+	require.Equal(t, entity.OutboxStatusFailed, OutboxRepository.FindOlderThanCalls()[0].Status)
+	require.Equal(t, 30*24*time.Hour, OutboxRepository.FindOlderThanCalls()[0].Age)
+	archives := *OutboxRepository.InsertArchiveCalls()[0].Archives
+	require.Equal(t, entity.OutboxStatusFailed, archives[0].Status)
+	require.Equal(t, "boom", archives[0].LastError)
+	require.Equal(t, []int64{1}, OutboxRepository.DeleteByIDsCalls()[0].Ids)
+}
+
+func TestOutboxUsecaseImpl_CleanupFailed_Fail_FindOlderThan(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	OutboxRepository := &mock.OutboxRepositoryMock{}
+
+	u := &outboxusecase.OutboxUsecaseImpl{
+		Config:           config.NewConfig(),
+		DB:               gormDB,
+		OutboxRepository: OutboxRepository,
+	}
+
+	OutboxRepository.FindOlderThanFunc = func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+		return assert.AnError
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	removed, err := u.CleanupFailed(context.Background(), time.Hour)
+
+	require.ErrorIs(t, err, assert.AnError)
+	require.Equal(t, int64(0), removed)
+	require.Empty(t, OutboxRepository.DeleteByIDsCalls())
+}
@@ -0,0 +1,90 @@
+package outboxusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"gorm.io/gorm"
+)
+
+// CleanupProduced removes produced outbox rows older than age, one bounded batch per
+// transaction, until no more rows qualify. When archiving is enabled the rows are
+// copied to the archive table before being deleted.
+func (u *OutboxUsecaseImpl) CleanupProduced(ctx context.Context, age time.Duration) (int64, error) {
+	total, err := u.cleanup(ctx, entity.OutboxStatusProduced, age)
+	if err != nil {
+		return total, errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).CleanupProduced")
+	}
+
+	return total, nil
+}
+
+// cleanup removes the outbox rows of status older than age, see CleanupProduced.
+func (u *OutboxUsecaseImpl) cleanup(ctx context.Context, status string, age time.Duration) (int64, error) {
+	batchSize := u.Config.GetOutboxCleanupBatchSize()
+	archive := u.Config.GetOutboxCleanupArchive()
+
+	var total int64
+	for ctx.Err() == nil {
+		removed, err := u.cleanupBatch(ctx, status, age, batchSize, archive)
+		if err != nil {
+			return total, errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).cleanup")
+		}
+
+		total += int64(removed)
+
+		if removed < batchSize {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+func (u *OutboxUsecaseImpl) cleanupBatch(ctx context.Context, status string, age time.Duration, batchSize int, archive bool) (int, error) {
+	removed := 0
+
+	err := u.DB.Transaction(func(tx *gorm.DB) error {
+		outboxes := entity.OutboxList{}
+		err := u.OutboxRepository.FindOlderThan(ctx, tx, &outboxes, status, age, batchSize)
+		if err != nil {
+			return errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).cleanupBatch")
+		}
+
+		if len(outboxes) == 0 {
+			return nil
+		}
+
+		if archive {
+			archives := entity.OutboxArchiveList{}
+			converter.EntityOutboxListToEntityOutboxArchiveList(outboxes, &archives)
+
+			err = u.OutboxRepository.InsertArchive(ctx, tx, &archives)
+			if err != nil {
+				return errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).cleanupBatch")
+			}
+		}
+
+		ids := make([]int64, 0, len(outboxes))
+		for _, outbox := range outboxes {
+			ids = append(ids, outbox.ID)
+		}
+
+		err = u.OutboxRepository.DeleteByIDs(ctx, tx, ids)
+		if err != nil {
+			return errkit.AddFuncName(err, "outboxusecase.(*OutboxUsecaseImpl).cleanupBatch")
+		}
+
+		removed = len(outboxes)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,125 @@
+package outboxusecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/outboxusecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestOutboxUsecaseImpl_CleanupProduced_Success_Delete(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	OutboxRepository := &mock.OutboxRepositoryMock{}
+
+	cfg := config.NewConfig()
+	cfg.Set(config.OutboxCleanupBatchSize, 2)
+	cfg.Set(config.OutboxCleanupArchive, false)
+
+	u := &outboxusecase.OutboxUsecaseImpl{
+		Config:           cfg,
+		DB:               gormDB,
+		OutboxRepository: OutboxRepository,
+	}
+
+	batches := []entity.OutboxList{
+		{{ID: 1}, {ID: 2}},
+		{{ID: 3}},
+	}
+	OutboxRepository.FindOlderThanFunc = func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+		*outboxes = batches[len(OutboxRepository.FindOlderThanCalls())-1]
+		return nil
+	}
+	OutboxRepository.DeleteByIDsFunc = func(ctx context.Context, db *gorm.DB, ids []int64) error {
+		return nil
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	removed, err := u.CleanupProduced(context.Background(), time.Hour)
+
+	require.Nil(t, err)
+	require.Equal(t, int64(3), removed)
+	require.Len(t, OutboxRepository.DeleteByIDsCalls(), 2)
+	require.Equal(t, []int64{1, 2}, OutboxRepository.DeleteByIDsCalls()[0].Ids)
+	require.Equal(t, []int64{3}, OutboxRepository.DeleteByIDsCalls()[1].Ids)
+	require.Equal(t, entity.OutboxStatusProduced, OutboxRepository.FindOlderThanCalls()[0].Status)
+}
+
+func TestOutboxUsecaseImpl_CleanupProduced_Success_Archive(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	OutboxRepository := &mock.OutboxRepositoryMock{}
+
+	cfg := config.NewConfig()
+	cfg.Set(config.OutboxCleanupBatchSize, 10)
+	cfg.Set(config.OutboxCleanupArchive, true)
+
+	u := &outboxusecase.OutboxUsecaseImpl{
+		Config:           cfg,
+		DB:               gormDB,
+		OutboxRepository: OutboxRepository,
+	}
+
+	OutboxRepository.FindOlderThanFunc = func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+		*outboxes = entity.OutboxList{{ID: 1, Topic: "image.liked"}}
+		return nil
+	}
+	OutboxRepository.InsertArchiveFunc = func(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+		return nil
+	}
+	OutboxRepository.DeleteByIDsFunc = func(ctx context.Context, db *gorm.DB, ids []int64) error {
+		return nil
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	removed, err := u.CleanupProduced(context.Background(), time.Hour)
+
+	require.Nil(t, err)
+	require.Equal(t, int64(1), removed)
+	require.Len(t, OutboxRepository.InsertArchiveCalls(), 1)
+	archives := *OutboxRepository.InsertArchiveCalls()[0].Archives
+	require.Equal(t, int64(1), archives[0].ID)
+	require.Equal(t, "image.liked", archives[0].Topic)
+}
+
+func TestOutboxUsecaseImpl_CleanupProduced_Fail_InsertArchive(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	OutboxRepository := &mock.OutboxRepositoryMock{}
+
+	cfg := config.NewConfig()
+	cfg.Set(config.OutboxCleanupArchive, true)
+
+	u := &outboxusecase.OutboxUsecaseImpl{
+		Config:           cfg,
+		DB:               gormDB,
+		OutboxRepository: OutboxRepository,
+	}
+
+	OutboxRepository.FindOlderThanFunc = func(ctx context.Context, db *gorm.DB, outboxes *entity.OutboxList, status string, age time.Duration, limit int) error {
+		*outboxes = entity.OutboxList{{ID: 1}}
+		return nil
+	}
+	OutboxRepository.InsertArchiveFunc = func(ctx context.Context, db *gorm.DB, archives *entity.OutboxArchiveList) error {
+		return assert.AnError
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	removed, err := u.CleanupProduced(context.Background(), time.Hour)
+
+	require.ErrorIs(t, err, assert.AnError)
+	require.Equal(t, int64(0), removed)
+	require.Empty(t, OutboxRepository.DeleteByIDsCalls())
+}
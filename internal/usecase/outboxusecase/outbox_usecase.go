@@ -0,0 +1,37 @@
+package outboxusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/config"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/outbound/repository"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out=../../mock/MockUsecaseOutbox.go -pkg=mock . OutboxUsecase
+
+type OutboxUsecase interface {
+	CleanupProduced(ctx context.Context, age time.Duration) (int64, error)
+	CleanupFailed(ctx context.Context, age time.Duration) (int64, error)
+}
+
+var _ OutboxUsecase = &OutboxUsecaseImpl{}
+
+type OutboxUsecaseImpl struct {
+	Config           *config.Config
+	DB               *gorm.DB
+	OutboxRepository repository.OutboxRepository
+}
+
+func NewOutboxUsecase(
+	cfg *config.Config,
+	db *gorm.DB,
+	outboxRepository repository.OutboxRepository,
+) *OutboxUsecaseImpl {
+	return &OutboxUsecaseImpl{
+		Config:           cfg,
+		DB:               db,
+		OutboxRepository: outboxRepository,
+	}
+}
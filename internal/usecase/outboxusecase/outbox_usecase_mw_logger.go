@@ -0,0 +1,54 @@
+package outboxusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/telemetry"
+	"github.com/sirupsen/logrus"
+)
+
+var _ OutboxUsecase = &OutboxUsecaseMwLogger{}
+
+type OutboxUsecaseMwLogger struct {
+	Next OutboxUsecase
+}
+
+func NewOutboxUsecaseMwLogger(next OutboxUsecase) *OutboxUsecaseMwLogger {
+	return &OutboxUsecaseMwLogger{
+		Next: next,
+	}
+}
+
+func (u *OutboxUsecaseMwLogger) CleanupProduced(ctx context.Context, age time.Duration) (int64, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	removed, err := u.Next.CleanupProduced(ctx, age)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"age":     age.String(),
+		"removed": removed,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return removed, err
+}
+
+func (u *OutboxUsecaseMwLogger) CleanupFailed(ctx context.Context, age time.Duration) (int64, error) {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	removed, err := u.Next.CleanupFailed(ctx, age)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"age":     age.String(),
+		"removed": removed,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return removed, err
+}
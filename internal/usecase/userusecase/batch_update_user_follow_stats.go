@@ -8,6 +8,7 @@ import (
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/logkit"
 	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
 )
 
 func (u *UserUsecaseImpl) BatchUpdateUserFollowStats(ctx context.Context, req dto.BatchUpdateUserFollowStatsRequest) error {
@@ -17,21 +18,27 @@ func (u *UserUsecaseImpl) BatchUpdateUserFollowStats(ctx context.Context, req dt
 		return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).BatchUpdateUserFollowStats")
 	}
 
-	for _, v := range req.UserIncreaseFollowerFollowingCountList {
-		var err error = nil
-		switch {
-		case v.HasFollowerCountAndFollowingCount():
-			err = u.UserStatRepository.IncrementFollowerCountAndFollowingCountByID(ctx, u.DB, v.UserID, v.FollowerCount, v.FollowingCount)
-		case v.HasFollowerCount():
-			err = u.UserStatRepository.IncrementFollowerCountByID(ctx, u.DB, v.UserID, v.FollowerCount)
-		case v.HasFollowingCount():
-			err = u.UserStatRepository.IncrementFollowingCountByID(ctx, u.DB, v.UserID, v.FollowingCount)
-		default:
-			logkit.Logger.WithContext(ctx).WithField("v", v).Warn("invalid follower count and following count")
-		}
-		if err != nil {
-			logkit.Logger.WithContext(ctx).WithError(err).Warn()
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range req.UserIncreaseFollowerFollowingCountList {
+			var err error = nil
+			switch {
+			case v.HasFollowerCountAndFollowingCount():
+				err = u.UserStatRepository.IncrementFollowerCountAndFollowingCountByID(ctx, tx, v.UserID, v.FollowerCount, v.FollowingCount)
+			case v.HasFollowerCount():
+				err = u.UserStatRepository.IncrementFollowerCountByID(ctx, tx, v.UserID, v.FollowerCount)
+			case v.HasFollowingCount():
+				err = u.UserStatRepository.IncrementFollowingCountByID(ctx, tx, v.UserID, v.FollowingCount)
+			default:
+				logkit.Logger.WithContext(ctx).WithField("v", v).Warn("no follower count or following count to update, skipped")
+			}
+			if err != nil {
+				return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).BatchUpdateUserFollowStats")
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,82 @@
+package userusecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/userusecase"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserUsecaseImpl_BatchUpdateUserFollowStats_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	UserStatRepository := &mock.UserStatRepositoryMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:                 gormDB,
+		UserStatRepository: UserStatRepository,
+	}
+
+	UserStatRepository.IncrementFollowerCountAndFollowingCountByIDFunc = func(ctx context.Context, db *gorm.DB, id int64, followerCount int, followingCount int) error {
+		return nil
+	}
+	UserStatRepository.IncrementFollowerCountByIDFunc = func(ctx context.Context, db *gorm.DB, id int64, count int) error {
+		return nil
+	}
+	UserStatRepository.IncrementFollowingCountByIDFunc = func(ctx context.Context, db *gorm.DB, id int64, count int) error {
+		return nil
+	}
+
+	req := dto.BatchUpdateUserFollowStatsRequest{
+		UserIncreaseFollowerFollowingCountList: dto.UserIncreaseFollowerFollowingCountList{
+			{UserID: 1, FollowerCount: 1, FollowingCount: -1},
+			{UserID: 2, FollowerCount: -2},
+			{UserID: 3, FollowingCount: 1},
+		},
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.BatchUpdateUserFollowStats(context.Background(), req)
+
+	require.Nil(t, err)
+	require.Len(t, UserStatRepository.IncrementFollowerCountAndFollowingCountByIDCalls(), 1)
+	require.Equal(t, -2, UserStatRepository.IncrementFollowerCountByIDCalls()[0].Count)
+	require.Equal(t, int64(3), UserStatRepository.IncrementFollowingCountByIDCalls()[0].ID)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestUserUsecaseImpl_BatchUpdateUserFollowStats_Success_SkipsNothingToUpdate(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	UserStatRepository := &mock.UserStatRepositoryMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:                 gormDB,
+		UserStatRepository: UserStatRepository,
+	}
+
+	UserStatRepository.IncrementFollowerCountByIDFunc = func(ctx context.Context, db *gorm.DB, id int64, count int) error {
+		return nil
+	}
+
+	req := dto.BatchUpdateUserFollowStatsRequest{
+		UserIncreaseFollowerFollowingCountList: dto.UserIncreaseFollowerFollowingCountList{
+			{UserID: 1, FollowerCount: 1},
+			{UserID: 2},
+		},
+	}
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.BatchUpdateUserFollowStats(context.Background(), req)
+
+	require.Nil(t, err)
+	require.Len(t, UserStatRepository.IncrementFollowerCountByIDCalls(), 1)
+	require.Equal(t, int64(1), UserStatRepository.IncrementFollowerCountByIDCalls()[0].ID)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
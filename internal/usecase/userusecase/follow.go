@@ -2,6 +2,7 @@ package userusecase
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
@@ -22,6 +23,12 @@ func (u *UserUsecaseImpl) Follow(ctx context.Context, req dto.FollowUserRequest)
 	follow := entity.Follow{}
 	converter.DtoFollowUserRequestToEntityFollow(ctx, req, &follow)
 
+	if follow.FollowerID == follow.FollowingID {
+		err = fmt.Errorf("user can not follow themselves")
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).Follow")
+	}
+
 	event := dto.UserFollowedEvent{}
 	converter.EntityFollowToDtoUserFollowedEvent(follow, &event)
 
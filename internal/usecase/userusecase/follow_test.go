@@ -0,0 +1,89 @@
+package userusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/userusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserUsecaseImpl_Follow_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	FollowRepository := &mock.FollowRepositoryMock{}
+	UserProducer := &mock.UserProducerMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:               gormDB,
+		FollowRepository: FollowRepository,
+		UserProducer:     UserProducer,
+	}
+
+	FollowRepository.CreateFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+		return nil
+	}
+
+	UserProducer.SendUserFollowedFunc = func(ctx context.Context, db *gorm.DB, event *dto.UserFollowedEvent) error {
+		return nil
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.Follow(ctx, dto.FollowUserRequest{FollowingID: 2})
+
+	require.Nil(t, err)
+	require.Len(t, UserProducer.SendUserFollowedCalls(), 1)
+}
+
+func TestUserUsecaseImpl_Follow_Fail_Self(t *testing.T) {
+	FollowRepository := &mock.FollowRepositoryMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		FollowRepository: FollowRepository,
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	err := u.Follow(ctx, dto.FollowUserRequest{FollowingID: 1})
+
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, FollowRepository.CreateCalls())
+}
+
+func TestUserUsecaseImpl_Follow_Fail_Duplicate(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	FollowRepository := &mock.FollowRepositoryMock{}
+	UserProducer := &mock.UserProducerMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:               gormDB,
+		FollowRepository: FollowRepository,
+		UserProducer:     UserProducer,
+	}
+
+	FollowRepository.CreateFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+		return errkit.SetCode(gorm.ErrDuplicatedKey, http.StatusConflict)
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.Follow(ctx, dto.FollowUserRequest{FollowingID: 2})
+
+	require.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+	require.Equal(t, http.StatusConflict, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, UserProducer.SendUserFollowedCalls())
+}
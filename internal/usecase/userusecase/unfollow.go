@@ -0,0 +1,52 @@
+package userusecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/converter"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/validatorkit"
+	"gorm.io/gorm"
+)
+
+func (u *UserUsecaseImpl) Unfollow(ctx context.Context, req dto.UnfollowUserRequest) error {
+	err := validatorkit.Validate.Struct(&req)
+	if err != nil {
+		err = errkit.SetCode(err, http.StatusBadRequest)
+		return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).Unfollow")
+	}
+
+	userAuth := ctxuserauth.Get(ctx)
+
+	err = u.DB.Transaction(func(tx *gorm.DB) error {
+		follow := entity.Follow{}
+		err := u.FollowRepository.FindByFollowerIDAndFollowingID(ctx, tx, &follow, userAuth.ID, req.FollowingID)
+		if err != nil {
+			return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).Unfollow")
+		}
+
+		err = u.FollowRepository.Delete(ctx, tx, &follow)
+		if err != nil {
+			return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).Unfollow")
+		}
+
+		event := dto.UserUnfollowedEvent{}
+		converter.EntityFollowToDtoUserUnfollowedEvent(follow, &event)
+
+		err = u.UserProducer.SendUserUnfollowed(ctx, tx, &event)
+		if err != nil {
+			return errkit.AddFuncName(err, "userusecase.(*UserUsecaseImpl).Unfollow")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package userusecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/mock"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/usecase/userusecase"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/ctx/ctxuserauth"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/errkit"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserUsecaseImpl_Unfollow_Success(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	FollowRepository := &mock.FollowRepositoryMock{}
+	UserProducer := &mock.UserProducerMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:               gormDB,
+		FollowRepository: FollowRepository,
+		UserProducer:     UserProducer,
+	}
+
+	FollowRepository.FindByFollowerIDAndFollowingIDFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+		follow.ID = 5
+		follow.FollowerID = followerID
+		follow.FollowingID = followingID
+		return nil
+	}
+
+	FollowRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+		return nil
+	}
+
+	var sent dto.UserUnfollowedEvent
+	UserProducer.SendUserUnfollowedFunc = func(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+		sent = *event
+		return nil
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectCommit()
+
+	err := u.Unfollow(ctx, dto.UnfollowUserRequest{FollowingID: 2})
+
+	require.Nil(t, err)
+	require.Equal(t, int64(5), FollowRepository.DeleteCalls()[0].Follow.ID)
+	require.Equal(t, dto.UserUnfollowedEvent{ID: 5, FollowerID: 1, FollowingID: 2}, sent)
+}
+
+func TestUserUsecaseImpl_Unfollow_Fail_ValidateStruct(t *testing.T) {
+	u := &userusecase.UserUsecaseImpl{}
+
+	err := u.Unfollow(context.Background(), dto.UnfollowUserRequest{})
+
+	require.NotNil(t, err)
+	var verrs validator.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+}
+
+func TestUserUsecaseImpl_Unfollow_Fail_NotFollowing(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	FollowRepository := &mock.FollowRepositoryMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:               gormDB,
+		FollowRepository: FollowRepository,
+	}
+
+	FollowRepository.FindByFollowerIDAndFollowingIDFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+		return errkit.SetCode(gorm.ErrRecordNotFound, http.StatusNotFound)
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.Unfollow(ctx, dto.UnfollowUserRequest{FollowingID: 2})
+
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.Equal(t, http.StatusNotFound, errkit.GetHTTPError(err).HTTPCode)
+	require.Empty(t, FollowRepository.DeleteCalls())
+}
+
+func TestUserUsecaseImpl_Unfollow_Fail_Send(t *testing.T) {
+	gormDB, mockDB := newFakeDB(t)
+	FollowRepository := &mock.FollowRepositoryMock{}
+	UserProducer := &mock.UserProducerMock{}
+
+	u := &userusecase.UserUsecaseImpl{
+		DB:               gormDB,
+		FollowRepository: FollowRepository,
+		UserProducer:     UserProducer,
+	}
+
+	FollowRepository.FindByFollowerIDAndFollowingIDFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow, followerID int64, followingID int64) error {
+		return nil
+	}
+
+	FollowRepository.DeleteFunc = func(ctx context.Context, db *gorm.DB, follow *entity.Follow) error {
+		return nil
+	}
+
+	UserProducer.SendUserUnfollowedFunc = func(ctx context.Context, db *gorm.DB, event *dto.UserUnfollowedEvent) error {
+		return assert.AnError
+	}
+
+	ctx := ctxuserauth.Set(context.Background(), &dto.UserAuth{ID: 1})
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectRollback()
+
+	err := u.Unfollow(ctx, dto.UnfollowUserRequest{FollowingID: 2})
+
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -21,6 +21,7 @@ type UserUsecase interface {
 	Current(ctx context.Context, req dto.GetUserRequest) (dto.UserResponse, error)
 	Update(ctx context.Context, req dto.UpdateUserRequest) (dto.UserResponse, error)
 	Follow(ctx context.Context, req dto.FollowUserRequest) error
+	Unfollow(ctx context.Context, req dto.UnfollowUserRequest) error
 	NotifyUserBeingFollowed(ctx context.Context, req dto.NotifyUserBeingFollowedRequest) error
 	BatchUpdateUserFollowStats(ctx context.Context, req dto.BatchUpdateUserFollowStatsRequest) error
 }
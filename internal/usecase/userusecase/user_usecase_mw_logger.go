@@ -36,6 +36,21 @@ func (u *UserUsecaseMwLogger) Follow(ctx context.Context, req dto.FollowUserRequ
 	return err
 }
 
+func (u *UserUsecaseMwLogger) Unfollow(ctx context.Context, req dto.UnfollowUserRequest) error {
+	ctx, span := telemetry.Start(ctx)
+	defer span.End()
+
+	err := u.Next.Unfollow(ctx, req)
+	telemetry.RecordError(span, err)
+
+	fields := logrus.Fields{
+		"req": req,
+	}
+	logkit.LogMw(ctx, fields, err)
+
+	return err
+}
+
 func (u *UserUsecaseMwLogger) Create(ctx context.Context, req dto.RegisterUserRequest) (dto.UserResponse, error) {
 	ctx, span := telemetry.Start(ctx)
 	defer span.End()
@@ -14,6 +14,14 @@ func (c Column) In(value any) (string, any) {
 	return string(c) + " IN ?", value
 }
 
+func (c Column) Lt(value any) (string, any) {
+	return string(c) + " < ?", value
+}
+
+func (c Column) Lte(value any) (string, any) {
+	return string(c) + " <= ?", value
+}
+
 func (c Column) Plus(value any) (string, any) {
 	return string(c) + " + ?", value
 }
@@ -29,6 +37,7 @@ const (
 	FollowerID     Column = "follower_id"
 	FollowingID    Column = "following_id"
 	URL            Column = "url"
+	Caption        Column = "caption"
 	LikeCount      Column = "like_count"
 	CommentCount   Column = "comment_count"
 	Username       Column = "username"
@@ -39,4 +48,7 @@ const (
 	Topic          Column = "topic"
 	Payload        Column = "payload"
 	Status         Column = "status"
+	Attempts       Column = "attempts"
+	LastError      Column = "last_error"
+	NextAttemptAt  Column = "next_attempt_at"
 )
@@ -1,17 +1,26 @@
 // Consumer group naming convention:
 // <topic>.<role>-<detail>
 //
+// A group consuming several topics is named after the first one.
+//
 // Roles:
 //
 //	notify - per-record, real-time notification delivery
 //	batch  - aggregated batch counter/stat updates
 //	sync   - one-way data sync to external systems
 //	log    - debugging/dummy consumer
+//
+// The consumer registry derives the rest: every group gets a "<group>.retry" group for
+// all its retry tier topics, and a single "dlq.log" group logs the dead letters of every
+// topic.
 package consumergroup
 
 const (
 	ImageUploadedNotifyFollowers = "image.uploaded.notify-followers"
 	ImageUploadedSyncSearch      = "image.uploaded.sync-search"
+	ImageUpdatedSyncSearch       = "image.updated.sync-search"
+	ImageDeletedSyncStorage      = "image.deleted.sync-storage"
+	ImageDeletedSyncSearch       = "image.deleted.sync-search"
 	ImageLikedNotifyOwner        = "image.liked.notify-owner"
 	ImageLikedBatchCount         = "image.liked.batch-count"
 	ImageCommentedNotifyOwner    = "image.commented.notify-owner"
@@ -21,16 +30,23 @@ const (
 	UserFollowedBatchStats = "user.followed.batch-stats"
 
 	NotifLog = "notif.log"
+)
 
-	ImageUploadedNotifyFollowersRetry = "image.uploaded.notify-followers.retry"
-	ImageUploadedSyncSearchRetry      = "image.uploaded.sync-search.retry"
-	ImageLikedNotifyOwnerRetry        = "image.liked.notify-owner.retry"
-	ImageLikedBatchCountRetry         = "image.liked.batch-count.retry"
-	ImageCommentedNotifyOwnerRetry    = "image.commented.notify-owner.retry"
-	ImageCommentedBatchCountRetry     = "image.commented.batch-count.retry"
+// All lists every group above. The consumer registry refuses to start when one of them is
+// not bound to a handler, or when it binds a group missing here.
+var All = []string{
+	ImageUploadedNotifyFollowers,
+	ImageUploadedSyncSearch,
+	ImageUpdatedSyncSearch,
+	ImageDeletedSyncStorage,
+	ImageDeletedSyncSearch,
+	ImageLikedNotifyOwner,
+	ImageLikedBatchCount,
+	ImageCommentedNotifyOwner,
+	ImageCommentedBatchCount,
 
-	UserFollowedNotifyUserRetry = "user.followed.notify-user.retry"
-	UserFollowedBatchStatsRetry = "user.followed.batch-stats.retry"
+	UserFollowedNotifyUser,
+	UserFollowedBatchStats,
 
-	NotifLogRetry = "notif.log.retry"
-)
+	NotifLog,
+}
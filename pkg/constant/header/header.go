@@ -0,0 +1,42 @@
+// Package header holds the Kafka record header keys used by producers and consumers.
+package header
+
+const (
+	IdempotencyKey = "x-idempotency-key"
+
+	// ContentType tells consumers which eventkit.Codec the record value is encoded with.
+	ContentType = "content-type"
+
+	RetryCount = "x-retry-count"
+	RetryDueAt = "x-retry-due-at"
+
+	// Failure cause, set every time a consumer routes a record to retry or DLQ.
+	ErrorMessage       = "x-error-message"
+	ErrorClass         = "x-error-class"
+	ErrorConsumerGroup = "x-error-consumer-group"
+
+	// Where the record first failed, set once and kept across retries.
+	OriginalTopic     = "x-original-topic"
+	OriginalPartition = "x-original-partition"
+	OriginalOffset    = "x-original-offset"
+	FirstFailureAt    = "x-first-failure-at"
+)
+
+const (
+	ErrorClassRetryable    = "retryable"
+	ErrorClassNonRetryable = "non-retryable"
+)
+
+// Failure lists the headers describing retries and failures. They are dropped when a
+// record is replayed from the DLQ so it starts over.
+var Failure = []string{
+	RetryCount,
+	RetryDueAt,
+	ErrorMessage,
+	ErrorClass,
+	ErrorConsumerGroup,
+	OriginalTopic,
+	OriginalPartition,
+	OriginalOffset,
+	FirstFailureAt,
+}
@@ -6,6 +6,7 @@ const (
 	Image              = "images"
 	Like               = "likes"
 	Outbox             = "outboxes"
+	OutboxArchive      = "outbox_archives"
 	User               = "users"
 	UserStat           = "user_stats"
 	MessageIdempotency = "message_idempotency"
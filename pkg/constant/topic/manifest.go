@@ -0,0 +1,32 @@
+package topic
+
+import "time"
+
+const (
+	PrimaryRetention = 7 * 24 * time.Hour
+	RetryRetention   = 7 * 24 * time.Hour
+	// DLQRetention leaves time to investigate and replay dead letters.
+	DLQRetention = 30 * 24 * time.Hour
+)
+
+// Spec declares how one topic is provisioned. The replication factor depends on the
+// cluster, so it comes from config instead.
+type Spec struct {
+	Name       string
+	Partitions int32
+	Retention  time.Duration
+}
+
+// Manifest returns the spec of every topic: each primary topic of All, its retryTiers
+// retry topics and its DLQ.
+func Manifest(retryTiers int) []Spec {
+	specs := []Spec{}
+	for _, t := range All {
+		specs = append(specs, Spec{Name: t.Primary, Partitions: t.Partitions, Retention: PrimaryRetention})
+		for tier := 1; tier <= retryTiers; tier++ {
+			specs = append(specs, Spec{Name: t.Retry(tier), Partitions: t.Partitions, Retention: RetryRetention})
+		}
+		specs = append(specs, Spec{Name: t.DLQ(), Partitions: t.Partitions, Retention: DLQRetention})
+	}
+	return specs
+}
@@ -1,11 +1,23 @@
 package topic
 
+import "strconv"
+
 type Topic struct {
 	Primary string
+
+	// Partitions of the primary topic, its retry and DLQ topics have as many, see Manifest.
+	Partitions int32
 }
 
-func (t Topic) Retry() string {
-	return t.Primary + ".retry"
+// Retry returns the topic of the given retry tier, starting at 1. Each tier is consumed
+// with its own delay, see config kafka.consumer.retry_delays_seconds. Tier 1 keeps the
+// name of the single retry topic used before tiers, so the records left in it are still
+// retried, and by the same consumer group.
+func (t Topic) Retry(tier int) string {
+	if tier == 1 {
+		return t.Primary + ".retry"
+	}
+	return t.Primary + ".retry." + strconv.Itoa(tier)
 }
 
 func (t Topic) DLQ() string {
@@ -13,9 +25,37 @@ func (t Topic) DLQ() string {
 }
 
 var (
-	ImageUploaded  = Topic{Primary: "image.uploaded"}
-	ImageLiked     = Topic{Primary: "image.liked"}
-	ImageCommented = Topic{Primary: "image.commented"}
-	UserFollowed   = Topic{Primary: "user.followed"}
-	Notif          = Topic{Primary: "notif"}
+	ImageUploaded       = Topic{Primary: "image.uploaded", Partitions: 6}
+	ImageUpdated        = Topic{Primary: "image.updated", Partitions: 6}
+	ImageDeleted        = Topic{Primary: "image.deleted", Partitions: 6}
+	ImageLiked          = Topic{Primary: "image.liked", Partitions: 12}
+	ImageUnliked        = Topic{Primary: "image.unliked", Partitions: 12}
+	ImageCommented      = Topic{Primary: "image.commented", Partitions: 6}
+	ImageCommentDeleted = Topic{Primary: "image.comment_deleted", Partitions: 6}
+	UserFollowed        = Topic{Primary: "user.followed", Partitions: 6}
+	UserUnfollowed      = Topic{Primary: "user.unfollowed", Partitions: 6}
+	Notif               = Topic{Primary: "notif", Partitions: 6}
 )
+
+var All = []Topic{
+	ImageUploaded,
+	ImageUpdated,
+	ImageDeleted,
+	ImageLiked,
+	ImageUnliked,
+	ImageCommented,
+	ImageCommentDeleted,
+	UserFollowed,
+	UserUnfollowed,
+	Notif,
+}
+
+// Find returns the topic whose primary name is primary.
+func Find(primary string) (Topic, bool) {
+	for _, t := range All {
+		if t.Primary == primary {
+			return t, true
+		}
+	}
+	return Topic{}, false
+}
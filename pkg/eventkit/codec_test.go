@@ -0,0 +1,66 @@
+package eventkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	at := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	for _, codec := range []Codec{JSON, Protobuf} {
+		t.Run(codec.ContentType(), func(t *testing.T) {
+			value, err := greetingSchema.Marshal(codec, "id-1", "test", "42", at, &greeting{FullName: "Ada"})
+			require.NoError(t, err)
+
+			got := greeting{}
+			envelope, err := greetingSchema.Unmarshal(codec, value, &got)
+
+			require.NoError(t, err)
+			require.Equal(t, greeting{FullName: "Ada"}, got)
+			require.Equal(t, "id-1", envelope.ID)
+			require.Equal(t, "test", envelope.Source)
+			require.Equal(t, "42", envelope.Subject)
+			require.Equal(t, at, envelope.Time)
+			require.Equal(t, 2, envelope.SchemaVersion)
+		})
+	}
+}
+
+func TestCodec_DecodingWithTheOtherCodecFails(t *testing.T) {
+	at := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	value, err := greetingSchema.Marshal(Protobuf, "id-1", "test", "42", at, greeting{FullName: "Ada"})
+	require.NoError(t, err)
+	_, err = greetingSchema.Unmarshal(JSON, value, &greeting{})
+	require.Error(t, err)
+}
+
+func TestCodec_Protobuf_SchemaWithoutBinding(t *testing.T) {
+	schema := Schema{Type: "greeting.sent", Version: 1}
+
+	_, err := schema.Marshal(Protobuf, "id-1", "test", "42", time.Now(), greeting{FullName: "Ada"})
+	require.Error(t, err)
+}
+
+func TestCodec_Protobuf_WrongDataType(t *testing.T) {
+	_, err := greetingSchema.Marshal(Protobuf, "id-1", "test", "42", time.Now(), "Ada")
+	require.Error(t, err)
+}
+
+func TestCodecFor(t *testing.T) {
+	for contentType, want := range map[string]Codec{
+		"":                             JSON,
+		ContentTypeCloudEventsJSON:     JSON,
+		ContentTypeCloudEventsProtobuf: Protobuf,
+	} {
+		got, err := CodecFor(contentType)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	_, err := CodecFor("application/avro")
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+// Package eventkit wraps every Kafka payload in a CloudEvents style envelope, so consumers
+// know what an event is and which schema version its data follows, and can upcast data
+// written by an older producer to the version they understand. How the envelope is
+// written on the wire is up to a Codec, see codec.go.
+package eventkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	SpecVersion = "1.0"
+
+	// Content types of Envelope.Data.
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/protobuf"
+
+	// LegacyVersion is the schema version of payloads produced before the envelope existed,
+	// which are the bare JSON data.
+	LegacyVersion = 1
+)
+
+// Envelope follows the CloudEvents 1.0 attributes, with the schemaversion extension
+// attribute holding the version of Data. Data is encoded as DataContentType says.
+type Envelope struct {
+	SpecVersion     string
+	ID              string
+	Source          string
+	Type            string
+	SchemaVersion   int
+	Subject         string
+	Time            time.Time
+	DataContentType string
+	Data            []byte
+}
+
+// Upcaster turns JSON data of one schema version into data of the next version.
+type Upcaster func(data json.RawMessage) (json.RawMessage, error)
+
+// Schema describes one event type. Bump Version on every incompatible change of the data
+// and add the upcaster from the previous version, so records already in flight, in retry
+// topics or in a DLQ waiting for replay still decode.
+type Schema struct {
+	Type    string
+	Version int
+
+	// Upcasters[v] upcasts data of version v to version v+1.
+	Upcasters map[int]Upcaster
+
+	// Proto lets the event be produced with the Protobuf codec, nil when it can not be.
+	Proto *ProtoBinding
+}
+
+// Marshal wraps data in an envelope of the current schema version and encodes it with
+// codec.
+func (s Schema) Marshal(codec Codec, id string, source string, subject string, t time.Time, data any) ([]byte, error) {
+	envelope := Envelope{
+		SpecVersion:   SpecVersion,
+		ID:            id,
+		Source:        source,
+		Type:          s.Type,
+		SchemaVersion: s.Version,
+		Subject:       subject,
+		Time:          t.UTC(),
+	}
+
+	value, err := codec.Encode(s, envelope, data)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s as %s: %w", s.Type, codec.ContentType(), err)
+	}
+	return value, nil
+}
+
+// Unmarshal decodes value with codec, upcasts its data to the current schema version and
+// decodes the data into dst.
+func (s Schema) Unmarshal(codec Codec, value []byte, dst any) (Envelope, error) {
+	envelope, err := codec.Decode(s, value, dst)
+	if err != nil {
+		return envelope, fmt.Errorf("decode %s as %s: %w", s.Type, codec.ContentType(), err)
+	}
+	return envelope, nil
+}
+
+// check rejects an envelope of another type or of a version newer than s.
+func (s Schema) check(envelope Envelope) error {
+	if envelope.Type != s.Type {
+		return fmt.Errorf("expected event type %s, got %s", s.Type, envelope.Type)
+	}
+
+	if envelope.SchemaVersion > s.Version {
+		return fmt.Errorf("%s schema version %d is newer than the supported version %d", s.Type, envelope.SchemaVersion, s.Version)
+	}
+
+	return nil
+}
+
+// upcast runs the upcasters from the version of envelope up to the current one.
+func (s Schema) upcast(envelope Envelope) (Envelope, error) {
+	for v := envelope.SchemaVersion; v < s.Version; v++ {
+		upcast, ok := s.Upcasters[v]
+		if !ok {
+			return envelope, fmt.Errorf("%s has no upcaster from schema version %d", s.Type, v)
+		}
+		data, err := upcast(envelope.Data)
+		if err != nil {
+			return envelope, fmt.Errorf("upcast %s from schema version %d: %w", s.Type, v, err)
+		}
+		envelope.Data = data
+		envelope.SchemaVersion = v + 1
+	}
+	return envelope, nil
+}
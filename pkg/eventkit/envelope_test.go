@@ -0,0 +1,86 @@
+package eventkit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type greeting struct {
+	FullName string `json:"full_name"`
+}
+
+// greetingSchema renamed "name" (v1) to "full_name" (v2).
+var greetingSchema = Schema{
+	Type:    "greeting.sent",
+	Version: 2,
+	Upcasters: map[int]Upcaster{
+		1: func(data json.RawMessage) (json.RawMessage, error) {
+			v1 := struct {
+				Name string `json:"name"`
+			}{}
+			err := json.Unmarshal(data, &v1)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(greeting{FullName: v1.Name})
+		},
+	},
+	Proto: NewProtoBinding(
+		func() *wrapperspb.StringValue { return &wrapperspb.StringValue{} },
+		func(src greeting, dst *wrapperspb.StringValue) { dst.Value = src.FullName },
+		func(src *wrapperspb.StringValue, dst *greeting) { dst.FullName = src.GetValue() },
+	),
+}
+
+func TestSchema_MarshalUnmarshal(t *testing.T) {
+	at := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	value, err := greetingSchema.Marshal(JSON, "id-1", "test", "42", at, greeting{FullName: "Ada"})
+	require.NoError(t, err)
+
+	got := greeting{}
+	envelope, err := greetingSchema.Unmarshal(JSON, value, &got)
+
+	require.NoError(t, err)
+	require.Equal(t, greeting{FullName: "Ada"}, got)
+	require.Equal(t, "id-1", envelope.ID)
+	require.Equal(t, "42", envelope.Subject)
+	require.Equal(t, at, envelope.Time)
+	require.Equal(t, 2, envelope.SchemaVersion)
+}
+
+func TestSchema_Unmarshal_UpcastsOlderVersion(t *testing.T) {
+	value := []byte(`{"specversion":"1.0","id":"id-1","type":"greeting.sent","schemaversion":1,"data":{"name":"Ada"}}`)
+
+	got := greeting{}
+	envelope, err := greetingSchema.Unmarshal(JSON, value, &got)
+
+	require.NoError(t, err)
+	require.Equal(t, greeting{FullName: "Ada"}, got)
+	require.Equal(t, 2, envelope.SchemaVersion)
+}
+
+func TestSchema_Unmarshal_LegacyPayloadWithoutEnvelope(t *testing.T) {
+	got := greeting{}
+	_, err := greetingSchema.Unmarshal(JSON, []byte(`{"name":"Ada"}`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, greeting{FullName: "Ada"}, got)
+}
+
+func TestSchema_Unmarshal_Fail(t *testing.T) {
+	for name, value := range map[string]string{
+		"other type":    `{"specversion":"1.0","type":"greeting.deleted","schemaversion":2,"data":{}}`,
+		"newer version": `{"specversion":"1.0","type":"greeting.sent","schemaversion":3,"data":{}}`,
+		"no upcaster":   `{"specversion":"1.0","type":"greeting.sent","schemaversion":0,"data":{}}`,
+		"not json":      `not json`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := greetingSchema.Unmarshal(JSON, []byte(value), &greeting{})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: eventkit/v1/envelope.proto
+
+package eventkitpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope is the protobuf form of eventkit.Envelope, used for records with content type
+// application/cloudevents+protobuf. data holds the event message named by type, encoded.
+type Envelope struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SpecVersion     string                 `protobuf:"bytes,1,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Source          string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SchemaVersion   int32                  `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Subject         string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	DataContentType string                 `protobuf:"bytes,8,opt,name=data_content_type,json=dataContentType,proto3" json:"data_content_type,omitempty"`
+	Data            []byte                 `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_eventkit_v1_envelope_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_eventkit_v1_envelope_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_eventkit_v1_envelope_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetSpecVersion() string {
+	if x != nil {
+		return x.SpecVersion
+	}
+	return ""
+}
+
+func (x *Envelope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Envelope) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Envelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Envelope) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Envelope) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Envelope) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Envelope) GetDataContentType() string {
+	if x != nil {
+		return x.DataContentType
+	}
+	return ""
+}
+
+func (x *Envelope) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_eventkit_v1_envelope_proto protoreflect.FileDescriptor
+
+const file_eventkit_v1_envelope_proto_rawDesc = "" +
+	"\n" +
+	"\x1aeventkit/v1/envelope.proto\x12\veventkit.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x02\n" +
+	"\bEnvelope\x12!\n" +
+	"\fspec_version\x18\x01 \x01(\tR\vspecVersion\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\x05R\rschemaVersion\x12\x18\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\x12.\n" +
+	"\x04time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12*\n" +
+	"\x11data_content_type\x18\b \x01(\tR\x0fdataContentType\x12\x12\n" +
+	"\x04data\x18\t \x01(\fR\x04dataBKZIgithub.com/Hidayathamir/golang-clean-architecture/pkg/eventkit/eventkitpbb\x06proto3"
+
+var (
+	file_eventkit_v1_envelope_proto_rawDescOnce sync.Once
+	file_eventkit_v1_envelope_proto_rawDescData []byte
+)
+
+func file_eventkit_v1_envelope_proto_rawDescGZIP() []byte {
+	file_eventkit_v1_envelope_proto_rawDescOnce.Do(func() {
+		file_eventkit_v1_envelope_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eventkit_v1_envelope_proto_rawDesc), len(file_eventkit_v1_envelope_proto_rawDesc)))
+	})
+	return file_eventkit_v1_envelope_proto_rawDescData
+}
+
+var file_eventkit_v1_envelope_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_eventkit_v1_envelope_proto_goTypes = []any{
+	(*Envelope)(nil),              // 0: eventkit.v1.Envelope
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_eventkit_v1_envelope_proto_depIdxs = []int32{
+	1, // 0: eventkit.v1.Envelope.time:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_eventkit_v1_envelope_proto_init() }
+func file_eventkit_v1_envelope_proto_init() {
+	if File_eventkit_v1_envelope_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eventkit_v1_envelope_proto_rawDesc), len(file_eventkit_v1_envelope_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_eventkit_v1_envelope_proto_goTypes,
+		DependencyIndexes: file_eventkit_v1_envelope_proto_depIdxs,
+		MessageInfos:      file_eventkit_v1_envelope_proto_msgTypes,
+	}.Build()
+	File_eventkit_v1_envelope_proto = out.File
+	file_eventkit_v1_envelope_proto_goTypes = nil
+	file_eventkit_v1_envelope_proto_depIdxs = nil
+}
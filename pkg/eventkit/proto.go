@@ -0,0 +1,45 @@
+package eventkit
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ProtoBinding maps the data of a schema to and from its Protobuf message.
+type ProtoBinding struct {
+	toMessage   func(data any) (proto.Message, error)
+	fromMessage func(raw []byte, dst any) error
+}
+
+// NewProtoBinding binds data D to message M. Encode accepts D or *D, decode needs a *D.
+func NewProtoBinding[D any, M proto.Message](newMessage func() M, from func(src D, dst M), to func(src M, dst *D)) *ProtoBinding {
+	return &ProtoBinding{
+		toMessage: func(data any) (proto.Message, error) {
+			message := newMessage()
+			switch d := data.(type) {
+			case D:
+				from(d, message)
+			case *D:
+				from(*d, message)
+			default:
+				return nil, fmt.Errorf("expected data %T, got %T", *new(D), data)
+			}
+			return message, nil
+		},
+		fromMessage: func(raw []byte, dst any) error {
+			d, ok := dst.(*D)
+			if !ok {
+				return fmt.Errorf("expected destination %T, got %T", new(D), dst)
+			}
+
+			message := newMessage()
+			err := proto.Unmarshal(raw, message)
+			if err != nil {
+				return fmt.Errorf("unmarshal data: %w", err)
+			}
+			to(message, d)
+			return nil
+		},
+	}
+}
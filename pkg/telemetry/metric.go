@@ -0,0 +1,64 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meter comes from the global provider, so the instruments below are no-ops until a
+// MeterProvider is registered with otel.SetMeterProvider.
+var meter metric.Meter = otel.Meter(instrumentationScope)
+
+var (
+	consumerProcessed, _ = meter.Int64Counter(
+		"messaging.consumer.processed",
+		metric.WithDescription("Number of records processed by a consumer handler, by outcome."),
+	)
+	consumerDuration, _ = meter.Float64Histogram(
+		"messaging.consumer.duration",
+		metric.WithDescription("Time a consumer handler took to process one record or one batch."),
+		metric.WithUnit("s"),
+	)
+	consumerAbandoned, _ = meter.Int64UpDownCounter(
+		"messaging.consumer.abandoned",
+		metric.WithDescription("Number of consumer handlers still running after they were abandoned on timeout."),
+	)
+	consumerStoppedPartitions, _ = meter.Int64UpDownCounter(
+		"messaging.consumer.stopped_partitions",
+		metric.WithDescription("Number of partitions held back by a failed record that cannot be routed to its retry or DLQ topic."),
+	)
+)
+
+// RecordConsumed records that a consumer group processed count records of topicName in
+// duration, with outcome one of "success", "retryable" or "non-retryable".
+func RecordConsumed(ctx context.Context, consumerGroup string, topicName string, outcome string, count int, duration time.Duration) {
+	attrs := metric.WithAttributes(
+		attribute.String("messaging.consumer.group.name", consumerGroup),
+		attribute.String("messaging.destination.name", topicName),
+		attribute.String("outcome", outcome),
+	)
+	consumerProcessed.Add(ctx, int64(count), attrs)
+	consumerDuration.Record(ctx, duration.Seconds(), attrs)
+}
+
+// AddAbandonedHandler adds delta to the handlers of consumerGroup that are still running
+// after they were abandoned, 1 when one is abandoned and -1 once it returns.
+func AddAbandonedHandler(ctx context.Context, consumerGroup string, delta int64) {
+	consumerAbandoned.Add(ctx, delta, metric.WithAttributes(
+		attribute.String("messaging.consumer.group.name", consumerGroup),
+	))
+}
+
+// AddStoppedPartition adds delta to the partitions of topicName consumed by consumerGroup
+// that are held back by a failed record they cannot route, 1 when one is held back and -1
+// once its record is routed or given up.
+func AddStoppedPartition(ctx context.Context, consumerGroup string, topicName string, delta int64) {
+	consumerStoppedPartitions.Add(ctx, delta, metric.WithAttributes(
+		attribute.String("messaging.consumer.group.name", consumerGroup),
+		attribute.String("messaging.destination.name", topicName),
+	))
+}
@@ -16,6 +16,17 @@ func StartController(ctx *fiber.Ctx) trace.Span {
 }
 
 func StartConsumer(ctx context.Context, record *kgo.Record, skips ...int) (context.Context, trace.Span) {
+	skip := 1
+	if len(skips) > 0 {
+		skip += skips[0]
+	}
+
+	return StartConsumerNamed(ctx, caller.FuncName(caller.WithSkip(skip)), record)
+}
+
+// StartConsumerNamed is StartConsumer with an explicit span name, for callers such as
+// middleware where the caller's function name says nothing about the consumer.
+func StartConsumerNamed(ctx context.Context, name string, record *kgo.Record) (context.Context, trace.Span) {
 	// record.Context contains the span context extracted from Kafka headers by kotel.
 	// We want to start a child span, but use the provided 'ctx' (shutdown context)
 	// as the base context so that cancellation is respected.
@@ -24,12 +35,7 @@ func StartConsumer(ctx context.Context, record *kgo.Record, skips ...int) (conte
 		ctx = trace.ContextWithRemoteSpanContext(ctx, remoteSpanCtx)
 	}
 
-	skip := 1
-	if len(skips) > 0 {
-		skip += skips[0]
-	}
-
-	return tracer.Start(ctx, caller.FuncName(caller.WithSkip(skip)))
+	return tracer.Start(ctx, name)
 }
 
 // StartConsumerBatch implements a "Batch + Bridge" tracing pattern for Kafka consumers.
@@ -48,6 +54,11 @@ func StartConsumer(ctx context.Context, record *kgo.Record, skips ...int) (conte
 // That is ai documentation, in my language, we connecting via trace.Link from
 // original trace (in producer) connected to new trace (in consumer that read batch).
 func StartConsumerBatch(originalCtx context.Context, records []*kgo.Record) (context.Context, trace.Span) {
+	return StartConsumerBatchNamed(originalCtx, caller.FuncName(caller.WithSkip(1)), records)
+}
+
+// StartConsumerBatchNamed is StartConsumerBatch with an explicit span name.
+func StartConsumerBatchNamed(originalCtx context.Context, name string, records []*kgo.Record) (context.Context, trace.Span) {
 	// Step 1: Collect all remote span contexts from the batch records.
 	var links []trace.Link
 	for _, record := range records {
@@ -58,13 +69,13 @@ func StartConsumerBatch(originalCtx context.Context, records []*kgo.Record) (con
 	}
 
 	// Create the Batch Span as an anchor for the whole operation, linking all producers.
-	ctx, span := tracer.Start(originalCtx, caller.FuncName(caller.WithSkip(1)), trace.WithLinks(links...))
+	ctx, span := tracer.Start(originalCtx, name, trace.WithLinks(links...))
 
 	// Step 2: Create "Bridge Spans" for individual record processing.
 	for _, record := range records {
 		// StartConsumer extracts the producer context from 'record.Context' and sets it as the PARENT.
 		// We use 'originalCtx' to ensure these inner spans are siblings to the batch span, not children.
-		_, innerSpan := StartConsumer(originalCtx, record, 1)
+		_, innerSpan := StartConsumerNamed(originalCtx, name, record)
 
 		// Create a link from the individual message trace back to the Batch Span.
 		spanCtx := span.SpanContext()
@@ -95,6 +95,76 @@ func TestUploadImage(t *testing.T) {
 	require.Equal(t, "My Caption", image.Caption)
 }
 
+func TestUpdateImageCaption(t *testing.T) {
+	ClearAll()
+
+	tokenA := registerAndLoginUser(t, "userA", "password", "User A")
+	tokenB := registerAndLoginUser(t, "userB", "password", "User B")
+
+	imageID := uploadImage(t, tokenA)
+
+	updateImage := func(token string, caption string) int {
+		bodyJson, err := json.Marshal(dto.UpdateImageRequest{Caption: caption})
+		require.Nil(t, err)
+
+		req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("http://127.0.0.1:3000/api/images/%d", imageID), bytes.NewReader(bodyJson))
+		require.Nil(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", bearerToken(token))
+
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		require.Nil(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusForbidden, updateImage(tokenB, "Caption from B"))
+	require.Equal(t, http.StatusOK, updateImage(tokenA, "Caption from A"))
+
+	image := entity.Image{}
+	err := db.First(&image, imageID).Error
+	require.Nil(t, err)
+	require.Equal(t, "Caption from A", image.Caption)
+}
+
+func TestDeleteImage(t *testing.T) {
+	ClearAll()
+
+	tokenA := registerAndLoginUser(t, "userA", "password", "User A")
+	tokenB := registerAndLoginUser(t, "userB", "password", "User B")
+
+	imageID := uploadImage(t, tokenA)
+	likeImage(t, tokenB, imageID)
+	commentImage(t, tokenB, imageID, "Comment from B")
+
+	deleteImage := func(token string) int {
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("http://127.0.0.1:3000/api/images/%d", imageID), nil)
+		require.Nil(t, err)
+		req.Header.Set("Authorization", bearerToken(token))
+
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		require.Nil(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusForbidden, deleteImage(tokenB))
+	require.Equal(t, http.StatusOK, deleteImage(tokenA))
+	require.Equal(t, http.StatusNotFound, deleteImage(tokenA))
+
+	// the image, its likes and its comments are soft deleted
+	var count int64
+	err := db.Model(&entity.Image{}).Where("id = ?", imageID).Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count)
+
+	for _, model := range []any{&entity.Like{}, &entity.Comment{}} {
+		err := db.Model(model).Where("image_id = ?", imageID).Count(&count).Error
+		require.Nil(t, err)
+		require.Equal(t, int64(0), count)
+	}
+}
+
 func TestLikeImage(t *testing.T) {
 	ClearAll()
 
@@ -131,6 +201,59 @@ func TestLikeImage(t *testing.T) {
 	require.Equal(t, int64(1), count)
 }
 
+func TestLikeImageTwice(t *testing.T) {
+	ClearAll()
+
+	token := registerAndLoginDefaultUser(t)
+	imageID := uploadImage(t, token)
+	likeImage(t, token, imageID)
+
+	bodyJson, err := json.Marshal(dto.LikeImageRequest{ImageID: imageID})
+	require.Nil(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:3000/api/images/_like", bytes.NewReader(bodyJson))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearerToken(token))
+
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer requireNil(t, res.Body.Close)
+
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+}
+
+func TestUnlikeImage(t *testing.T) {
+	ClearAll()
+
+	token := registerAndLoginDefaultUser(t)
+	imageID := uploadImage(t, token)
+	likeImage(t, token, imageID)
+
+	unlike := func() int {
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("http://127.0.0.1:3000/api/images/%d/likes", imageID), nil)
+		require.Nil(t, err)
+		req.Header.Set("Authorization", bearerToken(token))
+
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		require.Nil(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, unlike())
+
+	// the like is soft deleted, so liking again is allowed
+	var count int64
+	err := db.Model(&entity.Like{}).Where("image_id = ?", imageID).Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count)
+
+	require.Equal(t, http.StatusNotFound, unlike())
+
+	likeImage(t, token, imageID)
+}
+
 func TestCommentImage(t *testing.T) {
 	ClearAll()
 
@@ -338,6 +461,67 @@ func TestMultipleUsersCommentImage(t *testing.T) {
 	require.Len(t, respBody.Data, 2)
 }
 
+func TestUpdateAndDeleteComment(t *testing.T) {
+	ClearAll()
+
+	tokenA := registerAndLoginUser(t, "userA", "password", "User A")
+	tokenB := registerAndLoginUser(t, "userB", "password", "User B")
+	tokenC := registerAndLoginUser(t, "userC", "password", "User C")
+
+	// User A owns the image, B and C comment on it
+	imageID := uploadImage(t, tokenA)
+	commentImage(t, tokenB, imageID, "Comment from B")
+	commentImage(t, tokenC, imageID, "Comment from C")
+
+	commentList := entity.CommentList{}
+	err := db.Where("image_id = ?", imageID).Order("id").Find(&commentList).Error
+	require.Nil(t, err)
+	require.Len(t, commentList, 2)
+	commentB, commentC := commentList[0], commentList[1]
+
+	send := func(token string, method string, commentID int64, body any) int {
+		var reader io.Reader
+		if body != nil {
+			bodyJson, err := json.Marshal(body)
+			require.Nil(t, err)
+			reader = bytes.NewReader(bodyJson)
+		}
+
+		url := fmt.Sprintf("http://127.0.0.1:3000/api/images/%d/comments/%d", imageID, commentID)
+		req, err := http.NewRequest(method, url, reader)
+		require.Nil(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", bearerToken(token))
+
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		require.Nil(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	edit := dto.UpdateCommentRequest{Comment: "Edited by B"}
+
+	// only the commenter can edit, not even the image owner
+	require.Equal(t, http.StatusForbidden, send(tokenA, http.MethodPatch, commentB.ID, edit))
+	require.Equal(t, http.StatusOK, send(tokenB, http.MethodPatch, commentB.ID, edit))
+
+	comment := entity.Comment{}
+	err = db.First(&comment, commentB.ID).Error
+	require.Nil(t, err)
+	require.Equal(t, "Edited by B", comment.Comment)
+
+	// the commenter and the image owner can delete, other users can not
+	require.Equal(t, http.StatusForbidden, send(tokenC, http.MethodDelete, commentB.ID, nil))
+	require.Equal(t, http.StatusOK, send(tokenB, http.MethodDelete, commentB.ID, nil))
+	require.Equal(t, http.StatusOK, send(tokenA, http.MethodDelete, commentC.ID, nil))
+	require.Equal(t, http.StatusNotFound, send(tokenB, http.MethodDelete, commentB.ID, nil))
+
+	var count int64
+	err = db.Model(&entity.Comment{}).Where("image_id = ?", imageID).Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count)
+}
+
 func TestMultipleUsersLikeImage(t *testing.T) {
 	ClearAll()
 
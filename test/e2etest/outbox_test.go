@@ -0,0 +1,108 @@
+package e2etest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Hidayathamir/golang-clean-architecture/internal/dto"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/entity"
+	"github.com/Hidayathamir/golang-clean-architecture/internal/eventschema"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/header"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/constant/topic"
+	"github.com/Hidayathamir/golang-clean-architecture/pkg/eventkit"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// consumeRecordsByKey reads topicName starting from records produced after since and returns,
+// in consume order, every record whose key equals key. It stops once want records are found
+// or timeout expires.
+func consumeRecordsByKey(t *testing.T, topicName string, key string, since time.Time, want int, timeout time.Duration) []*kgo.Record {
+	t.Helper()
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(strings.Split(cfg.GetKafkaBootstrapServers(), ",")...),
+		kgo.ConsumeTopics(topicName),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AfterMilli(since.UnixMilli())),
+	)
+	require.Nil(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var result []*kgo.Record
+	for len(result) < want && ctx.Err() == nil {
+		fetches := client.PollFetches(ctx)
+		fetches.EachRecord(func(record *kgo.Record) {
+			if string(record.Key) == key {
+				result = append(result, record)
+			}
+		})
+	}
+
+	return result
+}
+
+func TestImageLikedOrderedPerKey(t *testing.T) {
+	ClearAll()
+
+	// Register users
+	tokenOwner := registerAndLoginUser(t, "owner", "password", "Owner")
+	likerTokens := []string{
+		registerAndLoginUser(t, "liker1", "password", "Liker One"),
+		registerAndLoginUser(t, "liker2", "password", "Liker Two"),
+		registerAndLoginUser(t, "liker3", "password", "Liker Three"),
+		registerAndLoginUser(t, "liker4", "password", "Liker Four"),
+	}
+
+	// Owner uploads image
+	imageID := uploadImage(t, tokenOwner)
+
+	// Likers like the image one after another
+	since := time.Now()
+	expectedLikerIDs := []int64{}
+	for i, token := range likerTokens {
+		likeImage(t, token, imageID)
+
+		liker := &entity.User{}
+		err := db.Where("username = ?", "liker"+strconv.Itoa(i+1)).First(liker).Error
+		require.Nil(t, err)
+		expectedLikerIDs = append(expectedLikerIDs, liker.ID)
+	}
+
+	// Verify outbox rows are keyed by image ID
+	key := strconv.FormatInt(imageID, 10)
+	var count int64
+	err := db.Model(&entity.Outbox{}).Where("topic = ? AND key = ?", topic.ImageLiked.Primary, key).Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(len(likerTokens)), count)
+
+	// Wait for outbox producer to publish, then verify partition and order
+	records := consumeRecordsByKey(t, topic.ImageLiked.Primary, key, since, len(likerTokens), 30*time.Second)
+	require.Len(t, records, len(likerTokens))
+
+	actualLikerIDs := []int64{}
+	for _, record := range records {
+		require.Equal(t, records[0].Partition, record.Partition)
+
+		contentType := ""
+		for _, h := range record.Headers {
+			if h.Key == header.ContentType {
+				contentType = string(h.Value)
+			}
+		}
+		codec, err := eventkit.CodecFor(contentType)
+		require.Nil(t, err)
+
+		event := dto.ImageLikedEvent{}
+		_, err = eventschema.ImageLiked.Unmarshal(codec, record.Value, &event)
+		require.Nil(t, err)
+		require.Equal(t, imageID, event.ImageID)
+		actualLikerIDs = append(actualLikerIDs, event.UserID)
+	}
+	require.Equal(t, expectedLikerIDs, actualLikerIDs)
+}
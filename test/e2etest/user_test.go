@@ -565,3 +565,71 @@ func TestUserFollowScenario(t *testing.T) {
 	checkFollow(t, userC.ID, userB.ID)
 	checkFollow(t, userB.ID, userA.ID)
 }
+
+// postFollow sends followingID to POST /api/users/<path> and returns the status code.
+func postFollow(t *testing.T, token string, path string, followingID int64) int {
+	t.Helper()
+
+	bodyJson, err := json.Marshal(dto.FollowUserRequest{FollowingID: followingID})
+	require.Nil(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:3000/api/users/"+path, strings.NewReader(string(bodyJson)))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", bearerToken(token))
+
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	require.Nil(t, res.Body.Close())
+
+	return res.StatusCode
+}
+
+func TestFollowUserGuards(t *testing.T) {
+	ClearAll()
+
+	tokenA := registerAndLoginUser(t, "user_a", "password", "User A")
+	registerUser(t, "user_b", "password", "User B")
+
+	userA := &entity.User{}
+	err := db.Where("username = ?", "user_a").First(userA).Error
+	require.Nil(t, err)
+
+	userB := &entity.User{}
+	err = db.Where("username = ?", "user_b").First(userB).Error
+	require.Nil(t, err)
+
+	require.Equal(t, http.StatusBadRequest, postFollow(t, tokenA, "_follow", userA.ID))
+
+	followUser(t, tokenA, userB.ID)
+	require.Equal(t, http.StatusConflict, postFollow(t, tokenA, "_follow", userB.ID))
+}
+
+func TestUnfollowUser(t *testing.T) {
+	ClearAll()
+
+	tokenA := registerAndLoginUser(t, "user_a", "password", "User A")
+	registerUser(t, "user_b", "password", "User B")
+
+	userA := &entity.User{}
+	err := db.Where("username = ?", "user_a").First(userA).Error
+	require.Nil(t, err)
+
+	userB := &entity.User{}
+	err = db.Where("username = ?", "user_b").First(userB).Error
+	require.Nil(t, err)
+
+	followUser(t, tokenA, userB.ID)
+	require.Equal(t, http.StatusOK, postFollow(t, tokenA, "_unfollow", userB.ID))
+
+	var count int64
+	err = db.Model(&entity.Follow{}).Where("follower_id = ? AND following_id = ?", userA.ID, userB.ID).Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count)
+
+	require.Equal(t, http.StatusNotFound, postFollow(t, tokenA, "_unfollow", userB.ID))
+
+	// following again after an unfollow is allowed
+	followUser(t, tokenA, userB.ID)
+}